@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("semver "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+
+	return fs
+}
+
+func runValidate(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("validate", stderr)
+	loose := fs.Bool("loose", false, "accept versions accepted by semver.Parse")
+
+	if fs.Parse(args) != nil || fs.NArg() == 0 {
+		return exitUsage
+	}
+
+	parse := semver.ParseStrict
+	if *loose {
+		parse = semver.Parse
+	}
+
+	status := 0
+
+	for _, arg := range fs.Args() {
+		if _, err := parse(arg); err != nil {
+			fmt.Fprintf(stdout, "%s: %s\n", arg, err)
+			status = 1
+		} else {
+			fmt.Fprintf(stdout, "%s: valid\n", arg)
+		}
+	}
+
+	return status
+}
+
+func runCompare(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("compare", stderr)
+
+	if fs.Parse(args) != nil || fs.NArg() != 2 {
+		return exitUsage
+	}
+
+	a, err := parseArg(fs.Arg(0), stderr)
+	if err != nil {
+		return 1
+	}
+
+	b, err := parseArg(fs.Arg(1), stderr)
+	if err != nil {
+		return 1
+	}
+
+	fmt.Fprintln(stdout, a.Compare(&b))
+	return 0
+}
+
+func runSort(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("sort", stderr)
+	reverse := fs.Bool("reverse", false, "sort from highest to lowest precedence")
+	latest := fs.Bool("latest", false, "print only the highest version")
+	pre := fs.Bool("prerelease", false, "allow --latest to select a prerelease")
+
+	if fs.Parse(args) != nil || fs.NArg() != 0 {
+		return exitUsage
+	}
+
+	// Versions are printed as they were given, so track the input line for
+	// each parsed version.
+	var versions semver.Collection
+	lines := map[*semver.Version]string{}
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		v, err := parseArg(line, stderr)
+		if err != nil {
+			return 1
+		}
+
+		versions = append(versions, &v)
+		lines[&v] = line
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, "semver:", err)
+		return 1
+	}
+
+	if *latest {
+		v := versions.Latest(*pre)
+		if v == nil {
+			fmt.Fprintln(stderr, "semver: no matching versions")
+			return 1
+		}
+
+		fmt.Fprintln(stdout, lines[v])
+		return 0
+	}
+
+	if *reverse {
+		versions.SortDescending()
+	} else {
+		versions.Sort()
+	}
+
+	for _, v := range versions {
+		fmt.Fprintln(stdout, lines[v])
+	}
+
+	return 0
+}
+
+func runBump(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	fs := newFlagSet("bump", stderr)
+	preid := fs.String("preid", "", "prerelease identifier used by the pre bump")
+
+	kind := args[0]
+	if fs.Parse(args[1:]) != nil || fs.NArg() != 1 {
+		return exitUsage
+	}
+
+	v, err := parseArg(fs.Arg(0), stderr)
+	if err != nil {
+		return 1
+	}
+
+	var out semver.Version
+
+	switch kind {
+	case "major":
+		out, err = v.NextMajor()
+	case "minor":
+		out, err = v.NextMinor()
+	case "patch":
+		out, err = v.NextPatch()
+	case "pre", "prerelease":
+		out, err = v.NextPrerelease(*preid)
+	default:
+		fmt.Fprintf(stderr, "semver: unknown bump %q\n", kind)
+		return exitUsage
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, "semver:", err)
+		return 1
+	}
+
+	fmt.Fprintln(stdout, formatLike(fs.Arg(0), &out))
+	return 0
+}
+
+func runSatisfies(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("satisfies", stderr)
+	pre := fs.Bool("prerelease", false, "match prereleases by precedence alone")
+
+	if fs.Parse(args) != nil || fs.NArg() < 2 {
+		return exitUsage
+	}
+
+	c, err := constraint.ParseConstraint(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, "semver:", err)
+		return exitUsage
+	}
+
+	policy := constraint.PrereleaseExclude
+	if *pre {
+		policy = constraint.PrereleaseInclude
+	}
+
+	status := 0
+
+	for _, arg := range fs.Args()[1:] {
+		v, err := parseArg(arg, stderr)
+		if err != nil {
+			status = exitUsage
+			continue
+		}
+
+		if c.CheckWith(&v, policy) {
+			fmt.Fprintln(stdout, arg)
+		} else if status == 0 {
+			status = 1
+		}
+	}
+
+	return status
+}
+
+func runFormat(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("format", stderr)
+	withV := false
+	fs.Var(setFlag{&withV, true}, "v", "print versions with a leading 'v'")
+	fs.Var(setFlag{&withV, false}, "no-v", "print versions without a leading 'v' (default)")
+
+	if fs.Parse(args) != nil || fs.NArg() == 0 {
+		return exitUsage
+	}
+
+	status := 0
+
+	for _, arg := range fs.Args() {
+		v, err := parseArg(arg, stderr)
+		if err != nil {
+			status = 1
+			continue
+		}
+
+		if withV {
+			fmt.Fprintln(stdout, v.VString())
+		} else {
+			fmt.Fprintln(stdout, v.String())
+		}
+	}
+
+	return status
+}
+
+// parseArg parses a version given on the command line, reporting any error.
+func parseArg(arg string, stderr io.Writer) (semver.Version, error) {
+	v, err := semver.Parse(arg)
+	if err != nil {
+		fmt.Fprintf(stderr, "semver: %s: %s\n", arg, err)
+	}
+
+	return v, err
+}
+
+// formatLike formats the given version with a leading 'v' if the given
+// original input had one.
+func formatLike(orig string, v *semver.Version) string {
+	if strings.HasPrefix(orig, "v") {
+		return v.VString()
+	}
+
+	return v.String()
+}
+
+// setFlag is a boolean flag that assigns a fixed value to its target when
+// given, or its opposite when given as false, allowing a pair of flags such as
+// --v and --no-v to share one target where the last flag given wins.
+type setFlag struct {
+	target *bool
+	value  bool
+}
+
+func (s setFlag) IsBoolFlag() bool {
+	return true
+}
+
+func (s setFlag) String() string {
+	return ""
+}
+
+func (s setFlag) Set(arg string) error {
+	on, err := strconv.ParseBool(arg)
+	if err != nil {
+		return err
+	}
+
+	*s.target = on == s.value
+	return nil
+}
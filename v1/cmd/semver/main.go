@@ -0,0 +1,86 @@
+// Command semver validates, compares, sorts, bumps, and formats semantic
+// version strings.
+//
+// Usage:
+//
+//	semver validate [--loose] <version...>
+//	semver compare <a> <b>
+//	semver sort [--reverse] [--latest] [--prerelease] < versions.txt
+//	semver bump major|minor|patch|pre [--preid <id>] <version>
+//	semver satisfies [--prerelease] <range> <version...>
+//	semver format [--v|--no-v] <version...>
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `usage: semver <command> [arguments]
+
+commands:
+  validate [--loose] <version...>
+        Validate each version, printing any errors.  Exits 1 if any version is
+        invalid.  Versions are validated against the SemVer 2.0.0 grammar
+        unless --loose is given.
+
+  compare <a> <b>
+        Print -1, 0, or 1 if a has a lower, equal, or higher precedence than b.
+
+  sort [--reverse] [--latest] [--prerelease]
+        Read versions from stdin, one per line, and print them in precedence
+        order.  With --latest only the highest release version is printed, or
+        the highest version of any kind if --prerelease is also given.
+
+  bump major|minor|patch|pre [--preid <id>] <version>
+        Print the next version of the given kind.
+
+  satisfies [--prerelease] <range> <version...>
+        Print each version satisfying the given range.  Exits 1 if any version
+        does not, or 2 if the range or any version is invalid.  Prereleases
+        only match ranges naming the same prerelease tuple unless --prerelease
+        is given.
+
+  format [--v|--no-v] <version...>
+        Print each version in canonical form, with or without a leading 'v'.
+`
+
+// exitUsage is returned for invalid command line arguments.
+const exitUsage = 2
+
+type command func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+
+var commands = map[string]command{
+	"validate":  runValidate,
+	"compare":   runCompare,
+	"sort":      runSort,
+	"bump":      runBump,
+	"satisfies": runSatisfies,
+	"format":    runFormat,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "semver: unknown command %q\n\n%s", args[0], usage)
+		return exitUsage
+	}
+
+	return cmd(args[1:], stdin, stdout, stderr)
+}
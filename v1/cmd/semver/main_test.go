@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		stdout string
+		status int
+	}{
+		{"no command", nil, "", "", exitUsage},
+		{"unknown command", []string{"frob"}, "", "", exitUsage},
+
+		{"validate", []string{"validate", "1.2.3", "v1.2.3"}, "", "1.2.3: valid\n" +
+			"v1.2.3: invalid semantic version string: missing number in major version at offset 0\n", 1},
+		{"validate loose", []string{"validate", "--loose", "v1.2.3"}, "", "v1.2.3: valid\n", 0},
+
+		{"compare lower", []string{"compare", "1.5.0", "2.0.0"}, "", "-1\n", 0},
+		{"compare equal", []string{"compare", "v1.0.0+b1", "1.0.0"}, "", "0\n", 0},
+		{"compare higher", []string{"compare", "1.0.0", "1.0.0-rc.1"}, "", "1\n", 0},
+		{"compare invalid", []string{"compare", "1.0.0", "1.x"}, "", "", 1},
+		{"compare arity", []string{"compare", "1.0.0"}, "", "", exitUsage},
+
+		{"sort", []string{"sort"}, "1.10.0\nv1.9.0\n\n2.0.0-rc.1\n2.0.0-beta.11\n2.0.0-beta.2\n",
+			"v1.9.0\n1.10.0\n2.0.0-beta.2\n2.0.0-beta.11\n2.0.0-rc.1\n", 0},
+		{"sort reverse", []string{"sort", "--reverse"}, "1.10.0\n1.9.0\n2.0.0-rc.1\n",
+			"2.0.0-rc.1\n1.10.0\n1.9.0\n", 0},
+		{"sort latest", []string{"sort", "--latest"}, "1.10.0\n1.9.0\n2.0.0-rc.1\n", "1.10.0\n", 0},
+		{"sort latest prerelease", []string{"sort", "--latest", "--prerelease"}, "1.10.0\n2.0.0-rc.1\n",
+			"2.0.0-rc.1\n", 0},
+		{"sort latest none", []string{"sort", "--latest"}, "2.0.0-rc.1\n", "", 1},
+		{"sort invalid", []string{"sort"}, "1.0.0\nnope\n", "", 1},
+
+		{"bump major", []string{"bump", "major", "v1.2.3"}, "", "v2.0.0\n", 0},
+		{"bump minor", []string{"bump", "minor", "1.2.3"}, "", "1.3.0\n", 0},
+		{"bump patch", []string{"bump", "patch", "1.2.3-rc.1"}, "", "1.2.3\n", 0},
+		{"bump pre", []string{"bump", "pre", "--preid", "rc", "1.2.3"}, "", "1.2.4-rc.0\n", 0},
+		{"bump pre again", []string{"bump", "pre", "--preid", "rc", "1.2.4-rc.0"}, "", "1.2.4-rc.1\n", 0},
+		{"bump unknown", []string{"bump", "huge", "1.2.3"}, "", "", exitUsage},
+
+		{"satisfies", []string{"satisfies", "^1.2", "1.3.0", "2.0.0", "1.4.0-beta"}, "", "1.3.0\n", 1},
+		{"satisfies all", []string{"satisfies", ">=1.0.0 <2", "1.3.0", "v1.0.0"}, "", "1.3.0\nv1.0.0\n", 0},
+		{"satisfies prerelease", []string{"satisfies", "--prerelease", "^1.2", "1.4.0-beta"}, "", "1.4.0-beta\n", 0},
+		{"satisfies invalid range", []string{"satisfies", "^1.2.3.4", "1.0.0"}, "", "", exitUsage},
+		{"satisfies invalid version", []string{"satisfies", "^1.2", "1.3.0", "1.x", "2.0.0"}, "", "1.3.0\n", exitUsage},
+
+		{"format", []string{"format", "v1.2.3", "1.0.0-rc.1"}, "", "1.2.3\n1.0.0-rc.1\n", 0},
+		{"format v", []string{"format", "--v", "1.2.3"}, "", "v1.2.3\n", 0},
+		{"format last flag wins", []string{"format", "--v", "--no-v", "v1.2.3"}, "", "1.2.3\n", 0},
+		{"format v false", []string{"format", "--v=false", "v1.2.3"}, "", "1.2.3\n", 0},
+		{"format no-v false", []string{"format", "--no-v=false", "1.2.3"}, "", "v1.2.3\n", 0},
+		{"format v invalid", []string{"format", "--v=maybe", "1.2.3"}, "", "", exitUsage},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			status := run(test.args, strings.NewReader(test.stdin), &stdout, &stderr)
+
+			if status != test.status {
+				t.Errorf("Expected exit status %d, got %d (stderr: %s)", test.status, status, stderr.String())
+			}
+			if stdout.String() != test.stdout {
+				t.Errorf("Expected output %q, got %q", test.stdout, stdout.String())
+			}
+		})
+	}
+}
+
+func TestRun_satisfiesInvalidRange(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	status := run([]string{"satisfies", "^1.2.3.4", "1.0.0"}, strings.NewReader(""), &stdout, &stderr)
+
+	if status != exitUsage {
+		t.Errorf("Expected exit status %d, got %d", exitUsage, status)
+	}
+	if !strings.HasPrefix(stderr.String(), "semver: ") || !strings.Contains(stderr.String(), "^1.2.3.4") {
+		t.Errorf("Expected the parse error on stderr, got %q", stderr.String())
+	}
+}
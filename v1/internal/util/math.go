@@ -10,3 +10,15 @@ func PowU8(in, pow uint8) (val uint8) {
 
 	return
 }
+
+// ShiftDigitU64 returns val*10+digit, and whether that result could be held in
+// a uint64 without overflowing.
+func ShiftDigitU64(val uint64, digit uint8) (uint64, bool) {
+	const max = ^uint64(0)
+
+	if val > (max-uint64(digit))/10 {
+		return val, false
+	}
+
+	return val*10 + uint64(digit), true
+}
@@ -34,3 +34,30 @@ func TestPowU8(t *testing.T) {
 		})
 	}
 }
+
+func TestShiftDigitU64(t *testing.T) {
+	tests := [...]struct {
+		val   uint64
+		digit uint8
+		out   uint64
+		ok    bool
+	}{
+		{0, 0, 0, true},
+		{0, 7, 7, true},
+		{1, 2, 12, true},
+		{1844674407370955161, 5, 18446744073709551615, true},
+		{1844674407370955161, 6, 1844674407370955161, false},
+		{1844674407370955162, 0, 1844674407370955162, false},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("(%d, %d) -> %d", test.val, test.digit, test.out), func(t *testing.T) {
+			val, ok := util.ShiftDigitU64(test.val, test.digit)
+			if ok != test.ok {
+				t.Errorf("Expected ok to be %t, got %t", test.ok, ok)
+			}
+			if val != test.out {
+				t.Errorf("Expected %d, got %d", test.out, val)
+			}
+		})
+	}
+}
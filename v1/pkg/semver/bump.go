@@ -0,0 +1,157 @@
+package semver
+
+// ErrOverflow is returned when incrementing a numeric version component would
+// overflow a uint64.
+var ErrOverflow error = sentinel("semantic version component overflows uint64")
+
+const maxComponent = ^uint64(0)
+
+// NextMajor returns the next major version following this Version.
+//
+// If this Version is a prerelease of a major version, such as 2.0.0-rc.1, the
+// prerelease is simply dropped, otherwise the major version is incremented and
+// the minor and patch versions are reset to zero.
+func (v *Version) NextMajor() (Version, error) {
+	if len(v.Prerelease) > 0 && v.Minor == 0 && v.Patch == 0 {
+		return Version{Major: v.Major}, nil
+	}
+
+	if v.Major == maxComponent {
+		return Version{}, ErrOverflow
+	}
+
+	return Version{Major: v.Major + 1}, nil
+}
+
+// NextMinor returns the next minor version following this Version.
+//
+// If this Version is a prerelease of a minor version, such as 1.2.0-rc.1, the
+// prerelease is simply dropped, otherwise the minor version is incremented and
+// the patch version is reset to zero.
+func (v *Version) NextMinor() (Version, error) {
+	if len(v.Prerelease) > 0 && v.Patch == 0 {
+		return Version{Major: v.Major, Minor: v.Minor}, nil
+	}
+
+	if v.Minor == maxComponent {
+		return Version{}, ErrOverflow
+	}
+
+	return Version{Major: v.Major, Minor: v.Minor + 1}, nil
+}
+
+// NextPatch returns the next patch version following this Version.
+//
+// If this Version is a prerelease, the prerelease is simply dropped, otherwise
+// the patch version is incremented.
+func (v *Version) NextPatch() (Version, error) {
+	if len(v.Prerelease) > 0 {
+		return v.Finalize(), nil
+	}
+
+	if v.Patch == maxComponent {
+		return Version{}, ErrOverflow
+	}
+
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, nil
+}
+
+// NextPrerelease returns the next prerelease version following this Version,
+// using the given prerelease identifier.
+//
+// If this Version is not a prerelease, the patch version is incremented and the
+// prerelease is set to "<preid>.0", so 1.2.3 becomes 1.2.4-rc.0.
+//
+// If this Version is a prerelease with the same leading identifier, or preid
+// is empty, the right-most numeric prerelease identifier is incremented, so
+// 1.2.4-rc.0 becomes 1.2.4-rc.1.  If no numeric identifier exists, ".0" is
+// appended.
+//
+// If this Version is a prerelease with a different leading identifier, the
+// prerelease is replaced with "<preid>.0", so 1.2.4-beta.3 becomes
+// 1.2.4-rc.0.
+func (v *Version) NextPrerelease(preid string) (Version, error) {
+	out := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+
+	switch true {
+	case len(v.Prerelease) == 0:
+		if v.Patch == maxComponent {
+			return Version{}, ErrOverflow
+		}
+		out.Patch++
+		out.Prerelease = startPrerelease(preid)
+
+	case preid == "" || v.Prerelease[0] == preid:
+		out.Prerelease = incrementPrerelease(v.Prerelease)
+
+	default:
+		out.Prerelease = startPrerelease(preid)
+	}
+
+	return out, nil
+}
+
+// Finalize returns the release version of this Version, with the prerelease
+// and build metadata removed.
+func (v *Version) Finalize() Version {
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+}
+
+// WithBuild returns a copy of this Version with its build metadata replaced by
+// the given identifiers.
+func (v *Version) WithBuild(build ...string) Version {
+	out := *v
+	out.Prerelease = copyIdentifiers(v.Prerelease)
+	out.Build = copyIdentifiers(build)
+
+	return out
+}
+
+func startPrerelease(preid string) []string {
+	if preid == "" {
+		return []string{"0"}
+	}
+
+	return []string{preid, "0"}
+}
+
+func incrementPrerelease(pre []string) []string {
+	out := copyIdentifiers(pre)
+
+	for i := len(out) - 1; i >= 0; i-- {
+		if isNumeric(out[i]) {
+			out[i] = incrementNumeric(out[i])
+			return out
+		}
+	}
+
+	return append(out, "0")
+}
+
+// incrementNumeric adds one to the given string of digits, allowing numeric
+// identifiers of any length.
+func incrementNumeric(id string) string {
+	buf := []byte(trimZeros(id))
+
+	for i := len(buf) - 1; i >= 0; i-- {
+		if buf[i] < digit9 {
+			buf[i]++
+			return string(buf)
+		}
+
+		buf[i] = digit0
+	}
+
+	return "1" + string(buf)
+}
+
+func copyIdentifiers(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	out := make([]string, len(ids))
+	copy(out, ids)
+
+	return out
+}
@@ -0,0 +1,112 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func TestVersion_Next(t *testing.T) {
+	tests := []struct {
+		input string
+		major string
+		minor string
+		patch string
+		final string
+	}{
+		{"1.2.3", "2.0.0", "1.3.0", "1.2.4", "1.2.3"},
+		{"1.2.3+b5", "2.0.0", "1.3.0", "1.2.4", "1.2.3"},
+		{"1.2.3-rc.1", "2.0.0", "1.3.0", "1.2.3", "1.2.3"},
+		{"1.2.0-rc.1", "2.0.0", "1.2.0", "1.2.0", "1.2.0"},
+		{"2.0.0-rc.1+b5", "2.0.0", "2.0.0", "2.0.0", "2.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			v, _ := semver.ParseStrict(test.input)
+
+			check := func(name, expect string, out semver.Version, err error) {
+				if err != nil {
+					t.Errorf("%s: expected no error, got %s", name, err)
+				} else if out.String() != expect {
+					t.Errorf("%s: expected %s, got %s", name, expect, out.String())
+				}
+			}
+
+			out, err := v.NextMajor()
+			check("NextMajor", test.major, out, err)
+			out, err = v.NextMinor()
+			check("NextMinor", test.minor, out, err)
+			out, err = v.NextPatch()
+			check("NextPatch", test.patch, out, err)
+			check("Finalize", test.final, v.Finalize(), nil)
+		})
+	}
+}
+
+func TestVersion_NextPrerelease(t *testing.T) {
+	tests := []struct {
+		input  string
+		preid  string
+		expect string
+	}{
+		{"1.2.3", "rc", "1.2.4-rc.0"},
+		{"1.2.4-rc.0", "rc", "1.2.4-rc.1"},
+		{"1.2.4-rc.9", "rc", "1.2.4-rc.10"},
+		{"1.2.4-rc.99+b5", "", "1.2.4-rc.100"},
+		{"1.2.4-beta.3", "rc", "1.2.4-rc.0"},
+		{"1.2.4-rc", "rc", "1.2.4-rc.0"},
+		{"1.2.4-rc.1.beta", "rc", "1.2.4-rc.2.beta"},
+		{"1.2.3", "", "1.2.4-0"},
+		{"1.2.4-0", "", "1.2.4-1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input+" "+test.preid, func(t *testing.T) {
+			v, _ := semver.ParseStrict(test.input)
+			out, err := v.NextPrerelease(test.preid)
+
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if out.String() != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, out.String())
+			}
+		})
+	}
+}
+
+func TestVersion_Next_overflow(t *testing.T) {
+	const max = ^uint64(0)
+
+	major := semver.Version{Major: max}
+	minor := semver.Version{Minor: max}
+	patch := semver.Version{Patch: max}
+
+	if _, err := major.NextMajor(); err != semver.ErrOverflow {
+		t.Errorf("NextMajor: expected ErrOverflow, got %v", err)
+	}
+	if _, err := minor.NextMinor(); err != semver.ErrOverflow {
+		t.Errorf("NextMinor: expected ErrOverflow, got %v", err)
+	}
+	if _, err := patch.NextPatch(); err != semver.ErrOverflow {
+		t.Errorf("NextPatch: expected ErrOverflow, got %v", err)
+	}
+	if _, err := patch.NextPrerelease("rc"); err != semver.ErrOverflow {
+		t.Errorf("NextPrerelease: expected ErrOverflow, got %v", err)
+	}
+}
+
+func TestVersion_WithBuild(t *testing.T) {
+	v := semver.Version{Major: 1, Prerelease: []string{"rc"}, Build: []string{"a"}}
+	out := v.WithBuild("b", "c")
+
+	if out.String() != "1.0.0-rc+b.c" {
+		t.Errorf("Expected 1.0.0-rc+b.c, got %s", out.String())
+	}
+
+	out.Prerelease[0] = "beta"
+	if v.Prerelease[0] != "rc" {
+		t.Error("Expected WithBuild to copy the prerelease identifiers")
+	}
+}
@@ -0,0 +1,250 @@
+// Package changelog reads, generates, and writes Markdown changelogs in the
+// Keep a Changelog format, with one section per semantic version.
+//
+// Sections read from an existing changelog retain their original text, so a
+// changelog may be parsed, have a new release section inserted, and be written
+// back without reformatting its history.
+package changelog
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Standard Keep a Changelog category names, in the order they are rendered.
+const (
+	CategoryAdded      = "Added"
+	CategoryChanged    = "Changed"
+	CategoryDeprecated = "Deprecated"
+	CategoryRemoved    = "Removed"
+	CategoryFixed      = "Fixed"
+	CategorySecurity   = "Security"
+)
+
+var categoryOrder = [...]string{
+	CategoryAdded,
+	CategoryChanged,
+	CategoryDeprecated,
+	CategoryRemoved,
+	CategoryFixed,
+	CategorySecurity,
+}
+
+// DefaultPreamble is the text placed above the first section by New.
+const DefaultPreamble = "# Changelog\n\n" +
+	"All notable changes to this project will be documented in this file.\n\n" +
+	"The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),\n" +
+	"and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).\n\n"
+
+var (
+	// ErrDuplicateVersion is returned when a changelog holds, or would hold,
+	// more than one section for versions of equal precedence.
+	ErrDuplicateVersion = errors.New("changelog: duplicate version section")
+
+	// ErrOutOfOrder is returned by Validate when a section does not follow the
+	// sections of higher precedence.
+	ErrOutOfOrder = errors.New("changelog: section out of precedence order")
+)
+
+// HeadingError describes an invalid or misplaced section heading.
+type HeadingError struct {
+	// Line is the 1-based line number of the heading.
+	Line int
+
+	// Heading is the text of the heading line.
+	Heading string
+
+	// Err is the underlying error.
+	Err error
+}
+
+func (h *HeadingError) Error() string {
+	return "changelog: line " + strconv.Itoa(h.Line) + ": heading " + strconv.Quote(h.Heading) +
+		": " + h.Err.Error()
+}
+
+func (h *HeadingError) Unwrap() error {
+	return h.Err
+}
+
+// Entry is a single categorized change.
+type Entry struct {
+	// Category is the name of the category, such as CategoryAdded.
+	Category string
+
+	// Text is the Markdown text of the entry, without a leading bullet.
+	Text string
+}
+
+// Category is a named group of entries within a section.
+type Category struct {
+	Name    string
+	Entries []string
+}
+
+// Link is a Markdown link reference definition, such as those used to link
+// section headings to a comparison of two tags.
+type Link struct {
+	Label string
+	URL   string
+}
+
+// Section is the portion of a changelog describing a single release.
+type Section struct {
+	// Version is the version of the release.  It is ignored if Unreleased is
+	// set.
+	Version semver.Version
+
+	// Unreleased marks the section holding changes not yet released.
+	Unreleased bool
+
+	// Date is the release date, conventionally in the form YYYY-MM-DD.
+	Date string
+
+	// Yanked marks a release that was pulled after publication.
+	Yanked bool
+
+	// Description is any text between the heading and the first category.
+	Description string
+
+	// Categories holds the entries of the release, grouped by category.
+	Categories []Category
+
+	// Link, if set, is added to the link references of a changelog as the
+	// target of the section heading when the section is inserted.
+	Link string
+
+	line int
+	raw  string
+}
+
+// NewSection returns a section for the release of version "to", holding the
+// given entries made since the release of version "from", grouped into
+// categories.  Standard categories are ordered as in Keep a Changelog, followed
+// by any other categories in order of first appearance.
+//
+// If linkFormat is not empty, the Link of the section is set to the result of
+// CompareLink for the two versions.  For a first release, with no previous
+// version, pass an empty linkFormat or a format without "{from}".
+func NewSection(from, to semver.Version, date, linkFormat string, entries []Entry) Section {
+	out := Section{Version: to, Date: date}
+
+	if linkFormat != "" {
+		out.Link = CompareLink(linkFormat, from, to)
+	}
+	index := make(map[string]int)
+
+	for _, name := range categoryOrder {
+		index[name] = len(out.Categories)
+		out.Categories = append(out.Categories, Category{Name: name})
+	}
+
+	for _, e := range entries {
+		i, ok := index[e.Category]
+		if !ok {
+			i = len(out.Categories)
+			index[e.Category] = i
+			out.Categories = append(out.Categories, Category{Name: e.Category})
+		}
+
+		out.Categories[i].Entries = append(out.Categories[i].Entries, e.Text)
+	}
+
+	kept := out.Categories[:0]
+	for _, c := range out.Categories {
+		if len(c.Entries) > 0 {
+			kept = append(kept, c)
+		}
+	}
+	out.Categories = kept
+
+	return out
+}
+
+// CompareLink returns the given URL format with each "{from}" replaced by the
+// string form of from and each "{to}" replaced by the string form of to.
+//
+// For example, "https://github.com/owner/repo/compare/v{from}...v{to}".
+func CompareLink(format string, from, to semver.Version) string {
+	return strings.NewReplacer("{from}", from.String(), "{to}", to.String()).Replace(format)
+}
+
+// Label returns the text of the section heading between square brackets.
+func (s *Section) Label() string {
+	if s.Unreleased {
+		return "Unreleased"
+	}
+
+	return s.Version.String()
+}
+
+// Category returns the entries of the named category, or nil if the section
+// has no such category.
+func (s *Section) Category(name string) []string {
+	for _, c := range s.Categories {
+		if c.Name == name {
+			return c.Entries
+		}
+	}
+
+	return nil
+}
+
+// Reformat discards the original text of a section read by Parse, so that it
+// is rendered from its fields when written.  Changes to the fields of a parsed
+// section are not written until Reformat is called.
+func (s *Section) Reformat() {
+	s.raw = ""
+}
+
+// Markdown renders the section from its fields, ending with a blank line.
+func (s *Section) Markdown() string {
+	var b strings.Builder
+
+	b.WriteString("## [")
+	b.WriteString(s.Label())
+	b.WriteByte(']')
+
+	if s.Date != "" {
+		b.WriteString(" - ")
+		b.WriteString(s.Date)
+	}
+
+	if s.Yanked {
+		b.WriteString(" [YANKED]")
+	}
+
+	b.WriteString("\n\n")
+
+	if s.Description != "" {
+		b.WriteString(s.Description)
+		b.WriteString("\n\n")
+	}
+
+	for _, c := range s.Categories {
+		b.WriteString("### ")
+		b.WriteString(c.Name)
+		b.WriteString("\n\n")
+
+		for _, e := range c.Entries {
+			b.WriteString("- ")
+			b.WriteString(e)
+			b.WriteByte('\n')
+		}
+
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+func (s *Section) text() string {
+	if s.raw != "" {
+		return s.raw
+	}
+
+	return s.Markdown()
+}
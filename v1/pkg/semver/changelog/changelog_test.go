@@ -0,0 +1,117 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/changelog"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/conventional"
+)
+
+func mustParse(t *testing.T, s string) semver.Version {
+	v, err := semver.Parse(s)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	return v
+}
+
+func TestNewSection(t *testing.T) {
+	sec := changelog.NewSection(mustParse(t, "1.1.0"), mustParse(t, "1.2.0"), "2024-03-01", "", []changelog.Entry{
+		{Category: changelog.CategoryFixed, Text: "fix one"},
+		{Category: "Documentation", Text: "docs"},
+		{Category: changelog.CategoryAdded, Text: "add one"},
+		{Category: changelog.CategoryFixed, Text: "fix two"},
+	})
+
+	expect := "## [1.2.0] - 2024-03-01\n\n" +
+		"### Added\n\n- add one\n\n" +
+		"### Fixed\n\n- fix one\n- fix two\n\n" +
+		"### Documentation\n\n- docs\n\n"
+
+	if got := sec.Markdown(); got != expect {
+		t.Errorf("Expected %q, got %q", expect, got)
+	}
+
+	if sec.Link != "" {
+		t.Errorf("Expected no link, got %s", sec.Link)
+	}
+}
+
+func TestNewSection_link(t *testing.T) {
+	sec := changelog.NewSection(mustParse(t, "1.1.0"), mustParse(t, "1.2.0"), "",
+		"https://example.com/compare/v{from}...v{to}", nil)
+
+	if expect := "https://example.com/compare/v1.1.0...v1.2.0"; sec.Link != expect {
+		t.Errorf("Expected %s, got %s", expect, sec.Link)
+	}
+
+	if sec.Label() != "1.2.0" {
+		t.Errorf("Expected 1.2.0, got %s", sec.Label())
+	}
+}
+
+func TestSection_Markdown(t *testing.T) {
+	tests := map[string]struct {
+		sec    changelog.Section
+		expect string
+	}{
+		"unreleased": {
+			changelog.Section{Unreleased: true},
+			"## [Unreleased]\n\n",
+		},
+		"yanked": {
+			changelog.Section{Version: semver.Version{Major: 0, Minor: 3, Patch: 1}, Date: "2020-01-01", Yanked: true},
+			"## [0.3.1] - 2020-01-01 [YANKED]\n\n",
+		},
+		"description": {
+			changelog.Section{Version: semver.Version{Major: 2}, Description: "Big release."},
+			"## [2.0.0]\n\nBig release.\n\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.sec.Markdown(); got != test.expect {
+				t.Errorf("Expected %q, got %q", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestCompareLink(t *testing.T) {
+	got := changelog.CompareLink("https://example.com/compare/v{from}...v{to}",
+		mustParse(t, "1.0.0"), mustParse(t, "1.1.0-rc.1"))
+
+	if expect := "https://example.com/compare/v1.0.0...v1.1.0-rc.1"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+}
+
+func TestFromCommits(t *testing.T) {
+	got := changelog.FromCommits([]conventional.Commit{
+		{Message: "feat(cli): add flag"},
+		{Message: "fix: crash"},
+		{Message: "chore: deps"},
+		{Message: "refactor!: drop api"},
+		{Message: "not conventional"},
+		{Message: "perf: faster"},
+	})
+
+	expect := []changelog.Entry{
+		{Category: changelog.CategoryAdded, Text: "**cli:** add flag"},
+		{Category: changelog.CategoryFixed, Text: "crash"},
+		{Category: changelog.CategoryChanged, Text: "**BREAKING:** drop api"},
+		{Category: changelog.CategoryChanged, Text: "faster"},
+	}
+
+	if len(got) != len(expect) {
+		t.Fatalf("Expected %d entries, got %d: %+v", len(expect), len(got), got)
+	}
+
+	for i := range expect {
+		if got[i] != expect[i] {
+			t.Errorf("Expected %+v, got %+v", expect[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package changelog
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver/conventional"
+)
+
+// FromCommits returns changelog entries for the given conventional commits.
+//
+// Commits of type "feat" are categorized as added, "fix" as fixed, and
+// "perf", "refactor", and "revert" as changed.  Breaking changes of any type
+// are categorized as changed and prefixed with "**BREAKING:**".  A commit
+// scope is rendered in bold before the description.  Other commits, including
+// those that are not conventional commits, are omitted.
+func FromCommits(commits []conventional.Commit) []Entry {
+	var out []Entry
+
+	for _, c := range commits {
+		msg, ok := conventional.ParseMessage(c.Message)
+		if !ok {
+			continue
+		}
+
+		var cat string
+
+		switch {
+		case msg.Breaking:
+			cat = CategoryChanged
+		case msg.Type == "feat":
+			cat = CategoryAdded
+		case msg.Type == "fix":
+			cat = CategoryFixed
+		case msg.Type == "perf", msg.Type == "refactor", msg.Type == "revert":
+			cat = CategoryChanged
+		default:
+			continue
+		}
+
+		text := msg.Description
+		if msg.Scope != "" {
+			text = "**" + msg.Scope + ":** " + text
+		}
+		if msg.Breaking {
+			text = "**BREAKING:** " + text
+		}
+
+		out = append(out, Entry{Category: cat, Text: text})
+	}
+
+	return out
+}
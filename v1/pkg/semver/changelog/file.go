@@ -0,0 +1,387 @@
+package changelog
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Changelog is a parsed changelog document.
+type Changelog struct {
+	// Preamble is the text preceding the first section.
+	Preamble string
+
+	// Sections holds the sections of the changelog in document order, which is
+	// normally the unreleased section followed by releases in descending
+	// precedence order.
+	Sections []Section
+
+	// Links holds the link reference definitions at the end of the document.
+	Links []Link
+}
+
+// New returns an empty changelog with the default preamble.
+func New() *Changelog {
+	return &Changelog{Preamble: DefaultPreamble}
+}
+
+// Parse reads a changelog document.
+//
+// Each level 2 heading starts a section and must name either "Unreleased" or
+// a valid semantic version, optionally in square brackets and optionally
+// prefixed with 'v', followed by an optional date and "[YANKED]" marker.  A
+// *HeadingError is returned for a heading naming an invalid version, or a
+// version of equal precedence to an earlier section.
+func Parse(r io.Reader) (*Changelog, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	out := new(Changelog)
+
+	end := trailingLinks(lines)
+	for _, l := range lines[end:] {
+		if link, ok := parseLink(strings.TrimSpace(l)); ok {
+			out.Links = append(out.Links, link)
+		}
+	}
+	lines = lines[:end]
+
+	var cur *Section
+	var body []string
+
+	flush := func() {
+		if cur != nil {
+			parseBody(cur, body)
+			out.Sections = append(out.Sections, *cur)
+		}
+	}
+
+	for i, l := range lines {
+		if !strings.HasPrefix(l, "## ") {
+			if cur == nil {
+				out.Preamble += l
+			} else {
+				cur.raw += l
+				body = append(body, strings.TrimRight(l, "\r\n"))
+			}
+			continue
+		}
+
+		flush()
+
+		heading := strings.TrimRight(l, "\r\n")
+		sec, err := parseHeading(strings.TrimSpace(heading[3:]))
+		if err != nil {
+			return nil, &HeadingError{Line: i + 1, Heading: heading, Err: err}
+		}
+
+		if out.Find(sec.Version, sec.Unreleased) != nil {
+			return nil, &HeadingError{Line: i + 1, Heading: heading, Err: ErrDuplicateVersion}
+		}
+
+		sec.line = i + 1
+		sec.raw = l
+		cur, body = &sec, nil
+	}
+
+	flush()
+
+	return out, nil
+}
+
+// Map returns the released sections of the changelog keyed by the string form
+// of their versions.  Versions are not comparable and so cannot be used as map
+// keys directly; use Find to look up a section by Version.
+func (c *Changelog) Map() map[string]*Section {
+	out := make(map[string]*Section, len(c.Sections))
+
+	for i := range c.Sections {
+		if !c.Sections[i].Unreleased {
+			out[c.Sections[i].Version.String()] = &c.Sections[i]
+		}
+	}
+
+	return out
+}
+
+// Find returns the section for a version of equal precedence to the given
+// version, or the unreleased section if unreleased is true.  Returns nil if no
+// such section exists.
+func (c *Changelog) Find(version semver.Version, unreleased bool) *Section {
+	for i := range c.Sections {
+		s := &c.Sections[i]
+
+		if s.Unreleased == unreleased && (unreleased || s.Version.Compare(&version) == 0) {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// Insert adds the given section to the changelog in precedence order: below
+// the unreleased section and any sections of higher precedence, and above the
+// first section of lower precedence.  An unreleased section is inserted at the
+// top.  If the section has a Link, it is added to the link references in the
+// same order.
+//
+// Returns ErrDuplicateVersion if the changelog already holds a section for a
+// version of equal precedence, or already holds an unreleased section.
+func (c *Changelog) Insert(s Section) error {
+	if c.Find(s.Version, s.Unreleased) != nil {
+		return ErrDuplicateVersion
+	}
+
+	s.line, s.raw = 0, ""
+
+	pos := 0
+	if !s.Unreleased {
+		for pos < len(c.Sections) {
+			other := &c.Sections[pos]
+			if !other.Unreleased && other.Version.Compare(&s.Version) < 0 {
+				break
+			}
+			pos++
+		}
+	}
+
+	c.Sections = append(c.Sections, Section{})
+	copy(c.Sections[pos+1:], c.Sections[pos:])
+	c.Sections[pos] = s
+
+	if s.Link != "" {
+		c.insertLink(Link{Label: s.Label(), URL: s.Link}, s)
+	}
+
+	return nil
+}
+
+func (c *Changelog) insertLink(link Link, s Section) {
+	pos := len(c.Links)
+
+	if s.Unreleased {
+		pos = 0
+	} else {
+		for i, l := range c.Links {
+			if v, err := semver.ParseStrict(strings.TrimPrefix(l.Label, "v")); err == nil && v.Compare(&s.Version) < 0 {
+				pos = i
+				break
+			}
+		}
+	}
+
+	c.Links = append(c.Links, Link{})
+	copy(c.Links[pos+1:], c.Links[pos:])
+	c.Links[pos] = link
+}
+
+// Validate checks that the sections of the changelog are in order: at most one
+// unreleased section, at the top, followed by releases in strictly descending
+// precedence order.  Returns a *HeadingError wrapping ErrOutOfOrder or
+// ErrDuplicateVersion describing the first misplaced section.  Sections not
+// read by Parse are reported with a Line of 0.
+func (c *Changelog) Validate() error {
+	for i := 1; i < len(c.Sections); i++ {
+		prev, s := &c.Sections[i-1], &c.Sections[i]
+
+		var err error
+
+		switch {
+		case s.Unreleased && prev.Unreleased:
+			err = ErrDuplicateVersion
+		case s.Unreleased:
+			err = ErrOutOfOrder
+		case prev.Unreleased:
+			continue
+		default:
+			switch cmp := prev.Version.Compare(&s.Version); {
+			case cmp == 0:
+				err = ErrDuplicateVersion
+			case cmp < 0:
+				err = ErrOutOfOrder
+			}
+		}
+
+		if err != nil {
+			return &HeadingError{Line: s.line, Heading: "## [" + s.Label() + "]", Err: err}
+		}
+	}
+
+	return nil
+}
+
+// WriteTo writes the changelog as Markdown.  Sections read by Parse are written
+// with their original text unless reformatted.
+func (c *Changelog) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, c.String())
+	return int64(n), err
+}
+
+// String returns the changelog as Markdown.
+func (c *Changelog) String() string {
+	var b strings.Builder
+
+	b.WriteString(c.Preamble)
+
+	for i := range c.Sections {
+		if c.Sections[i].raw == "" {
+			separate(&b)
+		}
+
+		b.WriteString(c.Sections[i].text())
+	}
+
+	if len(c.Links) > 0 {
+		separate(&b)
+	}
+
+	for _, l := range c.Links {
+		b.WriteByte('[')
+		b.WriteString(l.Label)
+		b.WriteString("]: ")
+		b.WriteString(l.URL)
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// separate ends the given builder with a blank line, unless it is empty.
+func separate(b *strings.Builder) {
+	s := b.String()
+
+	switch {
+	case s == "", strings.HasSuffix(s, "\n\n"):
+	case strings.HasSuffix(s, "\n"):
+		b.WriteByte('\n')
+	default:
+		b.WriteString("\n\n")
+	}
+}
+
+// trailingLinks returns the index of the first line of the block of link
+// reference definitions and blank lines ending the document, or len(lines) if
+// there is no such block.
+func trailingLinks(lines []string) int {
+	start := len(lines)
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		l := strings.TrimSpace(lines[i])
+
+		if l == "" {
+			continue
+		}
+
+		if _, ok := parseLink(l); !ok {
+			break
+		}
+
+		start = i
+	}
+
+	return start
+}
+
+func parseLink(l string) (Link, bool) {
+	if !strings.HasPrefix(l, "[") {
+		return Link{}, false
+	}
+
+	end := strings.Index(l, "]: ")
+	if end < 2 {
+		return Link{}, false
+	}
+
+	return Link{Label: l[1:end], URL: strings.TrimSpace(l[end+3:])}, true
+}
+
+func parseHeading(h string) (Section, error) {
+	var out Section
+	var label, rest string
+
+	if strings.HasPrefix(h, "[") {
+		end := strings.IndexByte(h, ']')
+		if end < 0 {
+			end = len(h)
+		}
+
+		label, rest = h[1:end], h[min(end+1, len(h)):]
+	} else if i := strings.IndexByte(h, ' '); i >= 0 {
+		label, rest = h[:i], h[i:]
+	} else {
+		label = h
+	}
+
+	rest = strings.TrimSpace(rest)
+
+	if upper := strings.ToUpper(rest); strings.HasSuffix(upper, "[YANKED]") {
+		out.Yanked = true
+		rest = strings.TrimSpace(rest[:len(rest)-8])
+	}
+
+	rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(rest, "-"), "–"))
+	out.Date = rest
+
+	if strings.EqualFold(label, "Unreleased") {
+		out.Unreleased = true
+		return out, nil
+	}
+
+	if len(label) > 0 && (label[0] == 'v' || label[0] == 'V') {
+		label = label[1:]
+	}
+
+	var err error
+	out.Version, err = semver.ParseStrict(label)
+
+	return out, err
+}
+
+func parseBody(s *Section, lines []string) {
+	var desc []string
+	var cat *Category
+
+	for _, l := range lines {
+		trimmed := strings.TrimSpace(l)
+
+		switch {
+		case trimmed == "":
+			continue
+
+		case strings.HasPrefix(l, "### "):
+			s.Categories = append(s.Categories, Category{Name: strings.TrimSpace(l[4:])})
+			cat = &s.Categories[len(s.Categories)-1]
+
+		case cat == nil:
+			desc = append(desc, l)
+
+		case strings.HasPrefix(l, "- ") || strings.HasPrefix(l, "* "):
+			cat.Entries = append(cat.Entries, strings.TrimSpace(l[2:]))
+
+		case len(cat.Entries) > 0:
+			cat.Entries[len(cat.Entries)-1] += "\n" + l
+
+		default:
+			cat.Entries = append(cat.Entries, trimmed)
+		}
+	}
+
+	s.Description = strings.Join(desc, "\n")
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,241 @@
+package changelog_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/changelog"
+)
+
+const sample = `# Changelog
+
+Some notes.
+
+## [Unreleased]
+
+### Added
+- upcoming thing
+
+## [1.1.0] - 2024-02-01
+Minor release.
+
+### Added
+
+- new thing that wraps
+  onto a second line
+* another thing
+
+### Fixed
+
+- bug
+
+## v1.0.0 - 2024-01-01 [YANKED]
+
+- stray entry
+
+## [0.9.0-beta.1]
+
+[Unreleased]: https://example.com/compare/v1.1.0...HEAD
+[1.1.0]: https://example.com/compare/v1.0.0...v1.1.0
+[1.0.0]: https://example.com/compare/v0.9.0-beta.1...v1.0.0
+`
+
+func parseSample(t *testing.T) *changelog.Changelog {
+	c, err := changelog.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	return c
+}
+
+func TestParse(t *testing.T) {
+	c := parseSample(t)
+
+	if c.Preamble != "# Changelog\n\nSome notes.\n\n" {
+		t.Errorf("Expected preamble, got %q", c.Preamble)
+	}
+
+	if len(c.Sections) != 4 {
+		t.Fatalf("Expected 4 sections, got %d", len(c.Sections))
+	}
+
+	if !c.Sections[0].Unreleased {
+		t.Errorf("Expected first section to be unreleased")
+	}
+
+	m := c.Map()
+	if len(m) != 3 {
+		t.Errorf("Expected 3 released sections, got %d", len(m))
+	}
+
+	minor := m["1.1.0"]
+	if minor == nil {
+		t.Fatal("Expected section for 1.1.0")
+	}
+
+	if minor.Date != "2024-02-01" || minor.Description != "Minor release." {
+		t.Errorf("Expected date and description, got %q, %q", minor.Date, minor.Description)
+	}
+
+	added := minor.Category(changelog.CategoryAdded)
+	if len(added) != 2 || added[0] != "new thing that wraps\n  onto a second line" || added[1] != "another thing" {
+		t.Errorf("Expected two added entries, got %q", added)
+	}
+
+	if fixed := minor.Category(changelog.CategoryFixed); len(fixed) != 1 || fixed[0] != "bug" {
+		t.Errorf("Expected one fixed entry, got %q", fixed)
+	}
+
+	major := m["1.0.0"]
+	if major == nil || !major.Yanked || major.Date != "2024-01-01" {
+		t.Errorf("Expected yanked 1.0.0 section, got %+v", major)
+	}
+
+	if m["0.9.0-beta.1"] == nil {
+		t.Errorf("Expected section for 0.9.0-beta.1")
+	}
+
+	if len(c.Links) != 3 || c.Links[1].Label != "1.1.0" {
+		t.Errorf("Expected 3 links, got %+v", c.Links)
+	}
+
+	if got := c.String(); got != sample {
+		t.Errorf("Expected round trip, got %q", got)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		line   int
+		target error
+	}{
+		"invalid version": {"# C\n\n## [1.2]\n", 3, semver.ErrInvalidVersion},
+		"not a version":   {"## [1.0.0]\n\n## Notes\n", 3, semver.ErrInvalidVersion},
+		"duplicate":       {"## [1.0.0]\n## [1.0.0+build]\n", 2, changelog.ErrDuplicateVersion},
+		"two unreleased":  {"## Unreleased\n## [Unreleased]\n", 2, changelog.ErrDuplicateVersion},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := changelog.Parse(strings.NewReader(test.input))
+
+			var herr *changelog.HeadingError
+			if !errors.As(err, &herr) {
+				t.Fatalf("Expected *HeadingError, got %v", err)
+			}
+
+			if herr.Line != test.line {
+				t.Errorf("Expected line %d, got %d", test.line, herr.Line)
+			}
+
+			if !errors.Is(err, test.target) {
+				t.Errorf("Expected %s, got %s", test.target, err)
+			}
+		})
+	}
+}
+
+func TestChangelog_Insert(t *testing.T) {
+	c := parseSample(t)
+
+	sec := changelog.NewSection(mustParse(t, "1.0.0"), mustParse(t, "1.0.1"), "2024-01-15",
+		"https://example.com/compare/v{from}...v{to}",
+		[]changelog.Entry{{Category: changelog.CategoryFixed, Text: "patch"}})
+
+	if err := c.Insert(sec); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := c.Insert(sec); err != changelog.ErrDuplicateVersion {
+		t.Errorf("Expected %s, got %v", changelog.ErrDuplicateVersion, err)
+	}
+
+	if err := c.Insert(changelog.Section{Unreleased: true}); err != changelog.ErrDuplicateVersion {
+		t.Errorf("Expected %s, got %v", changelog.ErrDuplicateVersion, err)
+	}
+
+	var labels []string
+	for _, s := range c.Sections {
+		labels = append(labels, s.Label())
+	}
+
+	if got, expect := strings.Join(labels, " "), "Unreleased 1.1.0 1.0.1 1.0.0 0.9.0-beta.1"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+
+	if c.Links[2].Label != "1.0.1" {
+		t.Errorf("Expected link for 1.0.1 at index 2, got %+v", c.Links)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+
+	expect := "- bug\n\n## [1.0.1] - 2024-01-15\n\n### Fixed\n\n- patch\n\n## v1.0.0 - 2024-01-01 [YANKED]\n"
+	if !strings.Contains(c.String(), expect) {
+		t.Errorf("Expected output to contain %q, got %q", expect, c.String())
+	}
+}
+
+func TestChangelog_InsertEmpty(t *testing.T) {
+	c := changelog.New()
+
+	for _, v := range []string{"1.0.0", "2.0.0", "1.5.0"} {
+		if err := c.Insert(changelog.NewSection(semver.Version{}, mustParse(t, v), "", "", nil)); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+	}
+
+	expect := changelog.DefaultPreamble + "## [2.0.0]\n\n## [1.5.0]\n\n## [1.0.0]\n\n"
+	if got := c.String(); got != expect {
+		t.Errorf("Expected %q, got %q", expect, got)
+	}
+}
+
+func TestChangelog_Validate(t *testing.T) {
+	c, err := changelog.Parse(strings.NewReader("## [1.0.0]\n\n## [Unreleased]\n"))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := c.Validate(); !errors.Is(err, changelog.ErrOutOfOrder) {
+		t.Errorf("Expected %s, got %v", changelog.ErrOutOfOrder, err)
+	}
+
+	c, err = changelog.Parse(strings.NewReader("## [1.0.0]\n\n## [1.0.0-rc.1]\n\n## [1.1.0]\n"))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var herr *changelog.HeadingError
+	if err := c.Validate(); !errors.As(err, &herr) || herr.Line != 5 || !errors.Is(err, changelog.ErrOutOfOrder) {
+		t.Errorf("Expected out of order error at line 5, got %v", err)
+	}
+}
+
+func TestSection_Reformat(t *testing.T) {
+	c, err := changelog.Parse(strings.NewReader("## 1.0.0\n- thing\n"))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	sec := c.Find(semver.Version{Major: 1}, false)
+	sec.Date = "2024-01-01"
+
+	if got := c.String(); got != "## 1.0.0\n- thing\n" {
+		t.Errorf("Expected original text, got %q", got)
+	}
+
+	sec.Reformat()
+
+	if got, expect := c.String(), "## [1.0.0] - 2024-01-01\n\n- thing\n\n"; got != expect {
+		t.Errorf("Expected %q, got %q", expect, got)
+	}
+}
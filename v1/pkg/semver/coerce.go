@@ -0,0 +1,269 @@
+package semver
+
+import (
+	"github.com/foxcapades/gVersion/v1/internal/util"
+)
+
+// Coercion is a set of flags describing the changes Coerce made to an input
+// string to produce a valid semantic version.
+type Coercion uint16
+
+const (
+	// CoercionTrimmedSpace indicates leading or trailing whitespace was removed.
+	CoercionTrimmedSpace Coercion = 1 << iota
+
+	// CoercionStrippedOperator indicates one or more leading '=' characters were
+	// removed.
+	CoercionStrippedOperator
+
+	// CoercionStrippedLeader indicates a leading 'v' or 'V' character was
+	// removed.
+	CoercionStrippedLeader
+
+	// CoercionStrippedPrefix indicates arbitrary text preceding the version
+	// number, such as "release-", was removed.
+	CoercionStrippedPrefix
+
+	// CoercionFilledComponents indicates a missing minor or patch component was
+	// set to zero.
+	CoercionFilledComponents
+
+	// CoercionDroppedComponents indicates numeric components following the patch
+	// version were removed.
+	CoercionDroppedComponents
+
+	// CoercionStrippedZeros indicates leading zeros were removed from a numeric
+	// component or numeric prerelease identifier.
+	CoercionStrippedZeros
+
+	// CoercionInsertedHyphen indicates a prerelease directly following the patch
+	// version, such as "1.2.3beta1", was separated with a hyphen.
+	CoercionInsertedHyphen
+
+	// CoercionDroppedIdentifiers indicates empty prerelease or build identifiers
+	// were removed.
+	CoercionDroppedIdentifiers
+
+	// CoercionReplacedCharacters indicates characters not permitted in
+	// prerelease or build identifiers were replaced with hyphens.
+	CoercionReplacedCharacters
+)
+
+var coercionNames = [...]string{
+	"trimmed space",
+	"stripped operator",
+	"stripped leader",
+	"stripped prefix",
+	"filled components",
+	"dropped components",
+	"stripped zeros",
+	"inserted hyphen",
+	"dropped identifiers",
+	"replaced characters",
+}
+
+// Has returns whether all of the given coercion flags are set.
+func (c Coercion) Has(flag Coercion) bool {
+	return c&flag == flag
+}
+
+// String returns a comma separated list of the names of the set flags.
+func (c Coercion) String() string {
+	out := make([]byte, 0, 32)
+
+	for i, name := range coercionNames {
+		if c.Has(1 << i) {
+			if len(out) > 0 {
+				out = append(out, ',', ' ')
+			}
+			out = append(out, name...)
+		}
+	}
+
+	return string(out)
+}
+
+// Coerce parses the given string leniently, normalizing common real-world
+// version formats such as "1", "1.2", "=v1.2.3", "V1.2.3", "1.2.3.4",
+// "release-1.4.2", and "1.2.3beta1" into a valid semantic version.
+//
+// The returned Coercion describes each normalization that was applied; it will
+// be zero if the input was already a valid SemVer 2.0.0 string.  The returned
+// Version is always valid according to ParseStrict.
+//
+// An error is returned if the input contains no version number, or if a
+// numeric component overflows.
+func Coerce(versionString string) (version Version, applied Coercion, err error) {
+	in := trimSpace(versionString)
+
+	if len(in) != len(versionString) {
+		applied |= CoercionTrimmedSpace
+	}
+
+	// Offset of in relative to the original input, used for error reporting.
+	off := 0
+	for off < len(versionString) && isSpace(versionString[off]) {
+		off++
+	}
+
+	for len(in) > 0 && in[0] == '=' {
+		in = in[1:]
+		off++
+		applied |= CoercionStrippedOperator
+	}
+
+	if len(in) > 0 && (in[0] == leader || in[0] == 'V') {
+		in = in[1:]
+		off++
+		applied |= CoercionStrippedLeader
+	}
+
+	start := 0
+	for start < len(in) && !isDigit(in[start]) {
+		start++
+	}
+
+	if start == len(in) {
+		return version, applied, newParseError(versionString, off+start, ComponentMajor, ReasonMissingNumber)
+	}
+
+	if start > 0 {
+		in = in[start:]
+		off += start
+		applied |= CoercionStrippedPrefix
+	}
+
+	pos := 0
+	parts := [vSegs]*uint64{&version.Major, &version.Minor, &version.Patch}
+
+	for i := 0; ; i++ {
+		numStart := pos
+
+		for ; pos < len(in) && isDigit(in[pos]); pos++ {
+			if i >= vSegs {
+				continue
+			}
+
+			var ok bool
+			if *parts[i], ok = util.ShiftDigitU64(*parts[i], in[pos]-digit0); !ok {
+				return version, applied, newParseError(versionString, off+pos, ComponentMajor+Component(i), ReasonOverflow)
+			}
+		}
+
+		if pos-numStart > 1 && in[numStart] == digit0 && i < vSegs {
+			applied |= CoercionStrippedZeros
+		}
+
+		if i == vSegs {
+			applied |= CoercionDroppedComponents
+		}
+
+		if pos+1 < len(in) && in[pos] == segDivider && isDigit(in[pos+1]) {
+			pos++
+			continue
+		}
+
+		if i < vSegs-1 {
+			applied |= CoercionFilledComponents
+		}
+
+		break
+	}
+
+	rest := in[pos:]
+
+	if len(rest) > 0 && rest[0] != preDivider && rest[0] != buildDivider {
+		if isIdentifierChar(rest[0]) {
+			applied |= CoercionInsertedHyphen
+			rest = string(preDivider) + rest
+		} else {
+			applied |= CoercionReplacedCharacters
+			rest = string(preDivider) + rest[1:]
+		}
+	}
+
+	if len(rest) > 0 && rest[0] == preDivider {
+		end := 1
+		for end < len(rest) && rest[end] != buildDivider {
+			end++
+		}
+
+		version.Prerelease = coerceIdentifiers(rest[1:end], true, &applied)
+		rest = rest[end:]
+	}
+
+	if len(rest) > 0 {
+		version.Build = coerceIdentifiers(rest[1:], false, &applied)
+	}
+
+	return
+}
+
+func coerceIdentifiers(in string, pre bool, applied *Coercion) (out []string) {
+	start := 0
+
+	for i := 0; i <= len(in); i++ {
+		if i < len(in) && in[i] != segDivider {
+			continue
+		}
+
+		if id := coerceIdentifier(in[start:i], pre, applied); len(id) > 0 {
+			out = append(out, id)
+		} else {
+			*applied |= CoercionDroppedIdentifiers
+		}
+
+		start = i + 1
+	}
+
+	return
+}
+
+func coerceIdentifier(id string, pre bool, applied *Coercion) string {
+	if pre && isNumeric(id) && len(id) > 1 && id[0] == digit0 {
+		*applied |= CoercionStrippedZeros
+		return trimZeros(id)
+	}
+
+	var buf []byte
+
+	for i := 0; i < len(id); i++ {
+		if isIdentifierChar(id[i]) {
+			continue
+		}
+
+		if buf == nil {
+			buf = []byte(id)
+		}
+
+		buf[i] = preDivider
+	}
+
+	if buf == nil {
+		return id
+	}
+
+	*applied |= CoercionReplacedCharacters
+	return string(buf)
+}
+
+func trimSpace(in string) string {
+	for len(in) > 0 && isSpace(in[0]) {
+		in = in[1:]
+	}
+
+	for len(in) > 0 && isSpace(in[len(in)-1]) {
+		in = in[:len(in)-1]
+	}
+
+	return in
+}
+
+func isSpace(b byte) bool {
+	switch b {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+
+	return false
+}
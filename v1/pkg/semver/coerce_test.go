@@ -0,0 +1,71 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func TestCoerce(t *testing.T) {
+	tests := []struct {
+		input   string
+		expect  string
+		applied semver.Coercion
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3-rc.1+b5", "1.2.3-rc.1+b5", 0},
+		{"1", "1.0.0", semver.CoercionFilledComponents},
+		{"1.2", "1.2.0", semver.CoercionFilledComponents},
+		{"=v1.2.3", "1.2.3", semver.CoercionStrippedOperator | semver.CoercionStrippedLeader},
+		{" 1.2.3 ", "1.2.3", semver.CoercionTrimmedSpace},
+		{"V1.2.3", "1.2.3", semver.CoercionStrippedLeader},
+		{"1.2.3.4", "1.2.3", semver.CoercionDroppedComponents},
+		{"release-1.4.2", "1.4.2", semver.CoercionStrippedPrefix},
+		{"1.2.3beta1", "1.2.3-beta1", semver.CoercionInsertedHyphen},
+		{"01.02.03", "1.2.3", semver.CoercionStrippedZeros},
+		{"1.0.0-rc.01", "1.0.0-rc.1", semver.CoercionStrippedZeros},
+		{"1.0.0-rc..1+", "1.0.0-rc.1", semver.CoercionDroppedIdentifiers},
+		{"1.0.0-rc_1+a b", "1.0.0-rc-1+a-b", semver.CoercionReplacedCharacters},
+		{"v1.2_beta", "1.2.0-beta", semver.CoercionStrippedLeader | semver.CoercionFilledComponents |
+			semver.CoercionReplacedCharacters},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ver, applied, err := semver.Coerce(test.input)
+
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if ver.String() != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, ver.String())
+			}
+			if applied != test.applied {
+				t.Errorf("Expected coercions [%s], got [%s]", test.applied, applied)
+			}
+			if _, err := semver.ParseStrict(ver.String()); err != nil {
+				t.Errorf("Expected coerced version to be strictly valid, got %s", err)
+			}
+		})
+	}
+}
+
+func TestCoerce_invalid(t *testing.T) {
+	tests := []string{"", "   ", "release", "v", "99999999999999999999"}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			if _, _, err := semver.Coerce(test); err == nil {
+				t.Error("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestCoercion_String(t *testing.T) {
+	val := semver.CoercionTrimmedSpace | semver.CoercionFilledComponents
+
+	if val.String() != "trimmed space, filled components" {
+		t.Errorf("Unexpected coercion string %q", val.String())
+	}
+}
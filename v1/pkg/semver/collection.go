@@ -0,0 +1,131 @@
+package semver
+
+import (
+	"sort"
+)
+
+// Collection is a sortable list of versions.
+//
+// Collections are ordered by SemVer precedence, with versions of equal
+// precedence ordered by their build metadata to keep the ordering stable.
+// Collections must not contain nil entries.
+type Collection []*Version
+
+func (c Collection) Len() int {
+	return len(c)
+}
+
+func (c Collection) Less(i, j int) bool {
+	return compareFull(c[i], c[j]) < 0
+}
+
+func (c Collection) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
+
+// Sort sorts the collection in place from lowest to highest precedence.
+func (c Collection) Sort() {
+	sort.Sort(c)
+}
+
+// SortDescending sorts the collection in place from highest to lowest
+// precedence.
+func (c Collection) SortDescending() {
+	sort.Sort(sort.Reverse(c))
+}
+
+// Max returns the version with the highest precedence in the collection, or
+// nil if the collection is empty.
+func (c Collection) Max() *Version {
+	var out *Version
+
+	for _, v := range c {
+		if out == nil || compareFull(v, out) > 0 {
+			out = v
+		}
+	}
+
+	return out
+}
+
+// Min returns the version with the lowest precedence in the collection, or nil
+// if the collection is empty.
+func (c Collection) Min() *Version {
+	var out *Version
+
+	for _, v := range c {
+		if out == nil || compareFull(v, out) < 0 {
+			out = v
+		}
+	}
+
+	return out
+}
+
+// Latest returns the version with the highest precedence in the collection,
+// optionally skipping prerelease versions.  Returns nil if the collection
+// contains no matching versions.
+func (c Collection) Latest(includePrerelease bool) *Version {
+	var out *Version
+
+	for _, v := range c {
+		if !includePrerelease && len(v.Prerelease) > 0 {
+			continue
+		}
+
+		if out == nil || compareFull(v, out) > 0 {
+			out = v
+		}
+	}
+
+	return out
+}
+
+// Dedupe returns a new collection, sorted from lowest to highest precedence,
+// containing only the first version of each distinct precedence found in this
+// collection.  Versions differing only by build metadata share a precedence.
+func (c Collection) Dedupe() Collection {
+	tmp := make(Collection, len(c))
+	copy(tmp, c)
+
+	sort.SliceStable(tmp, func(i, j int) bool {
+		return tmp[i].Compare(tmp[j]) < 0
+	})
+
+	out := tmp[:0]
+	for i, v := range tmp {
+		if i == 0 || v.Compare(out[len(out)-1]) != 0 {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// compareFull compares two versions by precedence, then by build metadata.
+func compareFull(a, b *Version) int {
+	if c := a.Compare(b); c != 0 {
+		return c
+	}
+
+	return compareBuild(a.Build, b.Build)
+}
+
+// compareBuild compares two sets of build identifiers using the same rules as
+// prerelease identifiers, except that an absent build sorts first.
+func compareBuild(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch true {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+
+	return 0
+}
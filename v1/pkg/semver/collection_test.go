@@ -0,0 +1,84 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func collection(t *testing.T, versions ...string) semver.Collection {
+	out := make(semver.Collection, len(versions))
+
+	for i, s := range versions {
+		v, err := semver.ParseStrict(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out[i] = &v
+	}
+
+	return out
+}
+
+func join(c semver.Collection) string {
+	out := make([]string, len(c))
+	for i := range c {
+		out[i] = c[i].String()
+	}
+	return strings.Join(out, " ")
+}
+
+func TestCollection_Sort(t *testing.T) {
+	c := collection(t, "1.10.0", "1.9.0", "2.0.0-rc.1", "1.0.0+b2", "2.0.0", "1.0.0+b10", "1.0.0", "2.0.0-beta.11", "2.0.0-beta.2")
+
+	c.Sort()
+	expect := "1.0.0 1.0.0+b10 1.0.0+b2 1.9.0 1.10.0 2.0.0-beta.2 2.0.0-beta.11 2.0.0-rc.1 2.0.0"
+	if join(c) != expect {
+		t.Errorf("Expected %s, got %s", expect, join(c))
+	}
+
+	c.SortDescending()
+	expect = "2.0.0 2.0.0-rc.1 2.0.0-beta.11 2.0.0-beta.2 1.10.0 1.9.0 1.0.0+b2 1.0.0+b10 1.0.0"
+	if join(c) != expect {
+		t.Errorf("Expected %s, got %s", expect, join(c))
+	}
+}
+
+func TestCollection_MaxMin(t *testing.T) {
+	c := collection(t, "1.9.0", "3.0.0-rc.1", "1.10.0", "0.1.0-alpha", "2.5.1")
+
+	if v := c.Max(); v.String() != "3.0.0-rc.1" {
+		t.Errorf("Max: expected 3.0.0-rc.1, got %s", v)
+	}
+	if v := c.Min(); v.String() != "0.1.0-alpha" {
+		t.Errorf("Min: expected 0.1.0-alpha, got %s", v)
+	}
+	if v := c.Latest(true); v.String() != "3.0.0-rc.1" {
+		t.Errorf("Latest(true): expected 3.0.0-rc.1, got %s", v)
+	}
+	if v := c.Latest(false); v.String() != "2.5.1" {
+		t.Errorf("Latest(false): expected 2.5.1, got %s", v)
+	}
+
+	var empty semver.Collection
+	if empty.Max() != nil || empty.Min() != nil || empty.Latest(true) != nil {
+		t.Error("Expected nil results for an empty collection")
+	}
+	if collection(t, "1.0.0-rc.1").Latest(false) != nil {
+		t.Error("Expected nil from Latest(false) with only prereleases")
+	}
+}
+
+func TestCollection_Dedupe(t *testing.T) {
+	c := collection(t, "1.0.0+b2", "2.0.0", "1.0.0", "1.0.0-rc.1", "2.0.0+b1", "1.0.0+b1")
+
+	out := c.Dedupe()
+	expect := "1.0.0-rc.1 1.0.0+b2 2.0.0"
+	if join(out) != expect {
+		t.Errorf("Expected %s, got %s", expect, join(out))
+	}
+	if len(c) != 6 || c[0].String() != "1.0.0+b2" {
+		t.Error("Expected Dedupe not to modify the original collection")
+	}
+}
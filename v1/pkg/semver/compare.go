@@ -0,0 +1,111 @@
+package semver
+
+func compareU64(a, b uint64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares two sets of prerelease identifiers.
+//
+// A version without prerelease identifiers has a higher precedence than one
+// with them.  Otherwise identifiers are compared one at a time from left to
+// right, and if all shared identifiers are equal, the larger set of
+// identifiers wins.
+func comparePrerelease(a, b []string) int {
+	switch true {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch true {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+
+	return 0
+}
+
+// compareIdentifier compares a single pair of prerelease identifiers.
+//
+// Identifiers consisting of only digits are compared numerically, all other
+// identifiers are compared lexically in ASCII sort order.  Numeric identifiers
+// always have a lower precedence than alphanumeric identifiers.
+func compareIdentifier(a, b string) int {
+	an, bn := isNumeric(a), isNumeric(b)
+
+	switch true {
+	case an && bn:
+		return compareNumeric(a, b)
+	case an:
+		return -1
+	case bn:
+		return 1
+	}
+
+	return compareStrings(a, b)
+}
+
+// compareNumeric compares two strings of digits by their numeric value without
+// parsing them, allowing identifiers of any length.
+func compareNumeric(a, b string) int {
+	a, b = trimZeros(a), trimZeros(b)
+
+	switch true {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+
+	return compareStrings(a, b)
+}
+
+func compareStrings(a, b string) int {
+	switch true {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func isNumeric(id string) bool {
+	if len(id) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] < digit0 || id[i] > digit9 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func trimZeros(id string) string {
+	for len(id) > 1 && id[0] == digit0 {
+		id = id[1:]
+	}
+
+	return id
+}
@@ -0,0 +1,106 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func TestVersion_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b semver.Version
+		se   int
+	}{
+		{
+			name: "equal",
+			a:    semver.Version{Major: 1, Minor: 2, Patch: 3},
+			b:    semver.Version{Major: 1, Minor: 2, Patch: 3},
+			se:   0,
+		},
+		{
+			name: "major checked before minor",
+			a:    semver.Version{Major: 1, Minor: 5},
+			b:    semver.Version{Major: 2},
+			se:   -1,
+		},
+		{
+			name: "minor checked before patch",
+			a:    semver.Version{Major: 2, Minor: 1},
+			b:    semver.Version{Major: 2, Minor: 0, Patch: 9},
+			se:   1,
+		},
+		{
+			name: "release follows prerelease",
+			a:    semver.Version{Major: 1},
+			b:    semver.Version{Major: 1, Prerelease: []string{"rc", "1"}},
+			se:   1,
+		},
+		{
+			name: "numeric identifiers compared numerically",
+			a:    semver.Version{Major: 1, Prerelease: []string{"beta", "2"}},
+			b:    semver.Version{Major: 1, Prerelease: []string{"beta", "11"}},
+			se:   -1,
+		},
+		{
+			name: "numeric identifier precedes alphanumeric",
+			a:    semver.Version{Major: 1, Prerelease: []string{"alpha", "1"}},
+			b:    semver.Version{Major: 1, Prerelease: []string{"alpha", "beta"}},
+			se:   -1,
+		},
+		{
+			name: "alphanumeric identifiers compared lexically",
+			a:    semver.Version{Major: 1, Prerelease: []string{"rc"}},
+			b:    semver.Version{Major: 1, Prerelease: []string{"beta"}},
+			se:   1,
+		},
+		{
+			name: "more identifiers follows fewer",
+			a:    semver.Version{Major: 1, Prerelease: []string{"alpha", "1"}},
+			b:    semver.Version{Major: 1, Prerelease: []string{"alpha"}},
+			se:   1,
+		},
+		{
+			name: "build metadata ignored",
+			a:    semver.Version{Major: 1, Build: []string{"b1"}},
+			b:    semver.Version{Major: 1, Build: []string{"b2"}},
+			se:   0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if val := test.a.Compare(&test.b); val != test.se {
+				t.Errorf("Expected %d, got %d", test.se, val)
+			}
+		})
+	}
+}
+
+func TestVersion_Compare_specOrder(t *testing.T) {
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"2.0.0",
+		"2.1.0",
+		"2.1.1",
+	}
+
+	for i := 1; i < len(ordered); i++ {
+		a, _ := semver.Parse(ordered[i-1])
+		b, _ := semver.Parse(ordered[i])
+
+		if a.Compare(&b) != -1 {
+			t.Errorf("Expected %s to precede %s", ordered[i-1], ordered[i])
+		}
+		if b.Compare(&a) != 1 {
+			t.Errorf("Expected %s to follow %s", ordered[i], ordered[i-1])
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Valid and invalid version strings from the test set published alongside the
+// regular expressions recommended by semver.org.
+var (
+	conformanceValid = []string{
+		"0.0.4",
+		"1.2.3",
+		"10.20.30",
+		"1.1.2-prerelease+meta",
+		"1.1.2+meta",
+		"1.1.2+meta-valid",
+		"1.0.0-alpha",
+		"1.0.0-beta",
+		"1.0.0-alpha.beta",
+		"1.0.0-alpha.beta.1",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha0.valid",
+		"1.0.0-alpha.0valid",
+		"1.0.0-alpha-a.b-c-somethinglong+build.1-aef.1-its-okay",
+		"1.0.0-rc.1+build.1",
+		"2.0.0-rc.1+build.123",
+		"1.2.3-beta",
+		"10.2.3-DEV-SNAPSHOT",
+		"1.2.3-SNAPSHOT-123",
+		"1.0.0",
+		"2.0.0",
+		"1.1.7",
+		"2.0.0+build.1848",
+		"2.0.1-alpha.1227",
+		"1.0.0-alpha+beta",
+		"1.2.3----RC-SNAPSHOT.12.9.1--.12+788",
+		"1.2.3----R-S.12.9.1--.12+meta",
+		"1.2.3----RC-SNAPSHOT.12.9.1--.12",
+		"1.0.0+0.build.1-rc.10000aaa-kk-0.1",
+		"1.0.0-0A.is.legal",
+	}
+
+	// conformanceOverflow holds grammatically valid versions whose numeric
+	// components do not fit in a uint64.
+	conformanceOverflow = []string{
+		"99999999999999999999999.999999999999999999.99999999999999999",
+	}
+
+	conformanceInvalid = []string{
+		"1",
+		"1.2",
+		"1.2.3-0123",
+		"1.2.3-0123.0123",
+		"1.1.2+.123",
+		"+invalid",
+		"-invalid",
+		"-invalid+invalid",
+		"-invalid.01",
+		"alpha",
+		"alpha.beta",
+		"alpha.beta.1",
+		"alpha.1",
+		"alpha+beta",
+		"alpha_beta",
+		"alpha.",
+		"alpha..",
+		"beta",
+		"1.0.0-alpha_beta",
+		"-alpha.",
+		"1.0.0-alpha..",
+		"1.0.0-alpha..1",
+		"1.0.0-alpha...1",
+		"1.0.0-alpha....1",
+		"1.0.0-alpha.....1",
+		"1.0.0-alpha......1",
+		"1.0.0-alpha.......1",
+		"01.1.1",
+		"1.01.1",
+		"1.1.01",
+		"1.2.3.DEV",
+		"1.2-SNAPSHOT",
+		"1.2.31.2.3----RC-SNAPSHOT.12.09.1--..12+788",
+		"1.2-RC-SNAPSHOT",
+		"-1.0.3-gamma+b7718",
+		"+justmeta",
+		"9.8.7+meta+meta",
+		"9.8.7-whatever+meta+meta",
+		"99999999999999999999999.999999999999999999.99999999999999999----RC-SNAPSHOT.12.09.1--------------------------------..12",
+		"",
+		"v1.2.3",
+		"1.2.3-a b",
+		"1.2.3-",
+		"1.2.3+",
+	}
+)
+
+func TestConformance_valid(t *testing.T) {
+	for _, test := range conformanceValid {
+		t.Run(test, func(t *testing.T) {
+			strict, err := semver.ParseStrict(test)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if strict.String() != test {
+				t.Errorf("Expected ParseStrict to round trip, got %s", strict.String())
+			}
+
+			loose, err := semver.Parse(test)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if loose.String() != test {
+				t.Errorf("Expected Parse to round trip, got %s", loose.String())
+			}
+
+			coerced, applied, err := semver.Coerce(test)
+			if err != nil || applied != 0 || coerced.String() != test {
+				t.Errorf("Expected Coerce to leave the version unchanged, got %s [%s] %v",
+					coerced.String(), applied, err)
+			}
+		})
+	}
+}
+
+func TestConformance_overflow(t *testing.T) {
+	for _, test := range conformanceOverflow {
+		t.Run(test, func(t *testing.T) {
+			if _, err := semver.ParseStrict(test); !errors.Is(err, semver.ReasonOverflow) {
+				t.Errorf("Expected an overflow error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestConformance_invalid(t *testing.T) {
+	for _, test := range conformanceInvalid {
+		t.Run(test, func(t *testing.T) {
+			_, err := semver.ParseStrict(test)
+
+			var pe *semver.ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("Expected a *ParseError, got %v", err)
+			}
+
+			// The lenient parser may accept or reject these inputs, but must
+			// never panic.
+			_, _ = semver.Parse(test)
+			_, _, _ = semver.Coerce(test)
+		})
+	}
+}
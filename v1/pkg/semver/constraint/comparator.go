@@ -0,0 +1,62 @@
+package constraint
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Operator is the relational operator of a Comparator.
+type Operator uint8
+
+const (
+	OpEqual Operator = iota
+	OpLess
+	OpLessEqual
+	OpGreater
+	OpGreaterEqual
+)
+
+var operatorSymbols = [...]string{"=", "<", "<=", ">", ">="}
+
+func (o Operator) String() string {
+	if int(o) < len(operatorSymbols) {
+		return operatorSymbols[o]
+	}
+
+	return "?"
+}
+
+// Comparator is a single operator and version pair, such as ">=1.2.3".
+type Comparator struct {
+	Operator Operator
+	Version  semver.Version
+}
+
+// Check returns whether the given version satisfies this comparator.
+func (c *Comparator) Check(v *semver.Version) bool {
+	cmp := v.Compare(&c.Version)
+
+	switch c.Operator {
+	case OpEqual:
+		return cmp == 0
+	case OpLess:
+		return cmp < 0
+	case OpLessEqual:
+		return cmp <= 0
+	case OpGreater:
+		return cmp > 0
+	case OpGreaterEqual:
+		return cmp >= 0
+	}
+
+	return false
+}
+
+// String returns the canonical string form of this comparator.  Equality
+// comparators are rendered without an operator.
+func (c *Comparator) String() string {
+	if c.Operator == OpEqual {
+		return c.Version.String()
+	}
+
+	return c.Operator.String() + c.Version.String()
+}
@@ -0,0 +1,159 @@
+// Package constraint implements a version range language for evaluating
+// semver.Version values against constraints such as ">=1.2.3 <2.0.0", "^1.4",
+// "~1.2.3", "1.x", "1.2.3 - 1.4.0", and unions of those joined with "||".
+//
+// Every range is desugared into a set of primitive comparators when parsed, so
+// "^1.4" is represented as ">=1.4.0 <2.0.0-0".
+package constraint
+
+import (
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Range is a set of comparators that must all be satisfied by a version.
+type Range []Comparator
+
+// Check returns whether the given version satisfies this range under the
+// PrereleaseExclude policy.
+func (r Range) Check(v *semver.Version) bool {
+	return r.CheckWith(v, PrereleaseExclude)
+}
+
+// CheckWith returns whether the given version satisfies every comparator in
+// this range, and is permitted by the given prerelease policy.
+func (r Range) CheckWith(v *semver.Version, policy PrereleasePolicy) bool {
+	return r.firstFailure(v) < 0 && policy.allows(r, v)
+}
+
+// firstFailure returns the index of the first comparator in the range not
+// satisfied by the given version, or -1 if every comparator is satisfied.
+func (r Range) firstFailure(v *semver.Version) int {
+	for i := range r {
+		if !r[i].Check(v) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (r Range) String() string {
+	if len(r) == 0 {
+		return "*"
+	}
+
+	parts := make([]string, len(r))
+	for i := range r {
+		parts[i] = r[i].String()
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Constraint is a union of ranges, a version satisfies the constraint if it
+// satisfies any one of those ranges.
+type Constraint struct {
+	Ranges []Range
+}
+
+// Check returns whether the given version satisfies this constraint under the
+// PrereleaseExclude policy.
+func (c *Constraint) Check(v *semver.Version) bool {
+	return c.CheckWith(v, PrereleaseExclude)
+}
+
+// CheckWith returns whether the given version satisfies this constraint under
+// the given prerelease policy.
+func (c *Constraint) CheckWith(v *semver.Version, policy PrereleasePolicy) bool {
+	for _, r := range c.Ranges {
+		if r.CheckWith(v, policy) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns nil if the given version satisfies this constraint under the
+// PrereleaseExclude policy, or an *UnsatisfiedError explaining why it does not.
+func (c *Constraint) Validate(v *semver.Version) error {
+	return c.ValidateWith(v, PrereleaseExclude)
+}
+
+// ValidateWith returns nil if the given version satisfies this constraint under
+// the given prerelease policy, or an *UnsatisfiedError explaining why it does
+// not.
+func (c *Constraint) ValidateWith(v *semver.Version, policy PrereleasePolicy) error {
+	if c.CheckWith(v, policy) {
+		return nil
+	}
+
+	out := &UnsatisfiedError{Version: *v, Constraint: c, Policy: policy}
+
+	for _, r := range c.Ranges {
+		if i := r.firstFailure(v); i >= 0 {
+			out.Failures = append(out.Failures, r[i])
+		} else {
+			out.Prerelease = true
+		}
+	}
+
+	return out
+}
+
+// String returns the desugared string form of this constraint.
+func (c *Constraint) String() string {
+	parts := make([]string, len(c.Ranges))
+	for i, r := range c.Ranges {
+		parts[i] = r.String()
+	}
+
+	return strings.Join(parts, " || ")
+}
+
+// UnsatisfiedError is returned by Constraint.Validate when a version does not
+// satisfy a constraint.
+type UnsatisfiedError struct {
+	Version    semver.Version
+	Constraint *Constraint
+
+	// Policy is the prerelease policy the version was checked under.
+	Policy PrereleasePolicy
+
+	// Failures holds the first comparator that rejected the version in each of
+	// the constraint's ranges, in order.  Ranges whose comparators were all
+	// satisfied are omitted.
+	Failures []Comparator
+
+	// Prerelease is true if the version satisfied every comparator of at least
+	// one range, but was rejected by the prerelease policy.
+	Prerelease bool
+}
+
+func (u *UnsatisfiedError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString(u.Version.String())
+	sb.WriteString(" does not satisfy \"")
+	sb.WriteString(u.Constraint.String())
+	sb.WriteString("\": ")
+
+	for i := range u.Failures {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString("not ")
+		sb.WriteString(u.Failures[i].String())
+	}
+
+	if u.Prerelease {
+		if len(u.Failures) > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString("prerelease not permitted by range")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,72 @@
+package constraint_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expect     bool
+	}{
+		{">=1.2.3 <2.0.0", "1.2.3", true},
+		{">=1.2.3 <2.0.0", "1.9.9", true},
+		{">=1.2.3 <2.0.0", "2.0.0", false},
+		{">=1.2.3 <2.0.0", "1.2.2", false},
+		{"^1.4", "1.10.0", true},
+		{"^1.4", "1.3.9", false},
+		{"^1.4", "2.0.0-alpha", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"1.x", "1.99.0", true},
+		{"1.x", "0.9.0", false},
+		{"1.2.3 - 1.4.0", "1.4.0", true},
+		{"1.2.3 - 1.4.0", "1.4.1", false},
+		{"1.2.3", "1.2.3+build", true},
+		{"<1.0.0 || >=2.0.0", "1.5.0", false},
+		{"<1.0.0 || >=2.0.0", "2.5.0", true},
+		{"*", "42.0.0", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.constraint+" "+test.version, func(t *testing.T) {
+			c := constraint.MustParseConstraint(test.constraint)
+			v, _ := semver.ParseStrict(test.version)
+
+			if c.Check(&v) != test.expect {
+				t.Errorf("Expected Check to return %t", test.expect)
+			}
+		})
+	}
+}
+
+func TestConstraint_Validate(t *testing.T) {
+	c := constraint.MustParseConstraint(">=1.2.3 <2.0.0 || ^3")
+	v := semver.Version{Major: 1}
+
+	err := c.Validate(&v)
+
+	var ue *constraint.UnsatisfiedError
+	if !errors.As(err, &ue) {
+		t.Fatalf("Expected an *UnsatisfiedError, got %#v", err)
+	}
+
+	if len(ue.Failures) != 2 {
+		t.Fatalf("Expected 2 failures, got %d", len(ue.Failures))
+	}
+
+	expect := `1.0.0 does not satisfy ">=1.2.3 <2.0.0 || >=3.0.0 <4.0.0-0": not >=1.2.3; not >=3.0.0`
+	if err.Error() != expect {
+		t.Errorf("Expected %q, got %q", expect, err.Error())
+	}
+
+	v.Minor = 5
+	if err := c.Validate(&v); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+}
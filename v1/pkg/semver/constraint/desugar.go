@@ -0,0 +1,124 @@
+package constraint
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// floor is the lowest possible prerelease identifier set, used to construct
+// exclusive upper bounds that also exclude prereleases of the bound itself.
+var floor = []string{"0"}
+
+// bump returns the lowest version following every version that shares the
+// first n components of the given version.
+//
+// The second return value is false if the increment overflowed, in which case
+// no upper bound exists.
+func bump(v semver.Version, n int) (semver.Version, bool) {
+	out := semver.Version{Prerelease: floor}
+
+	switch n {
+	case 1:
+		out.Major = v.Major + 1
+		return out, out.Major != 0
+	case 2:
+		out.Major, out.Minor = v.Major, v.Minor+1
+		return out, out.Minor != 0
+	default:
+		out.Major, out.Minor, out.Patch = v.Major, v.Minor, v.Patch+1
+		return out, out.Patch != 0
+	}
+}
+
+func lower(v semver.Version) Comparator {
+	return Comparator{OpGreaterEqual, v}
+}
+
+func below(r Range, v semver.Version, n int) Range {
+	if up, ok := bump(v, n); ok {
+		return append(r, Comparator{OpLess, up})
+	}
+
+	return r
+}
+
+// xRange desugars a bare partial version such as "1.2", "1.x", or "1.2.3".
+func xRange(p partial) Range {
+	switch p.set {
+	case 0:
+		return Range{}
+	case 3:
+		return Range{{OpEqual, p.version}}
+	}
+
+	return below(Range{lower(p.version)}, p.version, p.set)
+}
+
+// tildeRange desugars "~" ranges, which allow patch level changes if a minor
+// version is given, or minor level changes if not.
+func tildeRange(p partial) Range {
+	switch p.set {
+	case 0:
+		return Range{}
+	case 1:
+		return below(Range{lower(p.version)}, p.version, 1)
+	}
+
+	return below(Range{lower(p.version)}, p.version, 2)
+}
+
+// caretRange desugars "^" ranges, which allow changes that do not modify the
+// left-most nonzero component.
+func caretRange(p partial) Range {
+	switch true {
+	case p.set == 0:
+		return Range{}
+	case p.version.Major > 0 || p.set == 1:
+		return below(Range{lower(p.version)}, p.version, 1)
+	case p.version.Minor > 0 || p.set == 2:
+		return below(Range{lower(p.version)}, p.version, 2)
+	}
+
+	return below(Range{lower(p.version)}, p.version, 3)
+}
+
+func greaterRange(p partial, inclusive bool) Range {
+	switch true {
+	case p.set == 0:
+		if inclusive {
+			return Range{}
+		}
+		// Nothing is greater than every version.
+		return Range{{OpLess, semver.Version{Prerelease: floor}}}
+	case p.set == 3 && inclusive:
+		return Range{{OpGreaterEqual, p.version}}
+	case p.set == 3:
+		return Range{{OpGreater, p.version}}
+	case inclusive:
+		return Range{lower(p.version)}
+	}
+
+	if up, ok := bump(p.version, p.set); ok {
+		up.Prerelease = nil
+		return Range{{OpGreaterEqual, up}}
+	}
+
+	return Range{{OpLess, semver.Version{Prerelease: floor}}}
+}
+
+func lessRange(p partial, inclusive bool) Range {
+	switch true {
+	case p.set == 0:
+		if inclusive {
+			return Range{}
+		}
+		return Range{{OpLess, semver.Version{Prerelease: floor}}}
+	case p.set == 3 && inclusive:
+		return Range{{OpLessEqual, p.version}}
+	case p.set == 3:
+		return Range{{OpLess, p.version}}
+	case inclusive:
+		return below(Range{}, p.version, p.set)
+	}
+
+	return Range{{OpLess, semver.Version{Major: p.version.Major, Minor: p.version.Minor, Prerelease: floor}}}
+}
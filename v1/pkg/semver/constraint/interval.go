@@ -0,0 +1,400 @@
+package constraint
+
+import (
+	"sort"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// minVersion is the version of lowest precedence, "0.0.0-0".
+var minVersion = semver.Version{Prerelease: floor}
+
+// Bound is one end of an Interval.
+type Bound struct {
+	// Version is the version at the bound.  Build metadata is ignored.
+	Version semver.Version
+
+	// Inclusive is true if Version itself is within the interval.
+	Inclusive bool
+
+	// Unbounded is true if the interval extends without limit in the direction
+	// of this bound, in which case Version and Inclusive are ignored.
+	Unbounded bool
+}
+
+// Interval is a contiguous set of versions ordered by precedence, such as
+// ">=1.2.0 <2.0.0-0".
+type Interval struct {
+	Lower Bound
+	Upper Bound
+}
+
+// IsEmpty returns whether the interval holds no versions.
+func (i *Interval) IsEmpty() bool {
+	n := i.normalize()
+
+	if n.Upper.Unbounded {
+		return false
+	}
+
+	if n.Lower.Unbounded {
+		return !n.Upper.Inclusive && n.Upper.Version.Compare(&minVersion) <= 0
+	}
+
+	switch cmp := n.Lower.Version.Compare(&n.Upper.Version); {
+	case cmp > 0:
+		return true
+	case cmp == 0:
+		return !n.Lower.Inclusive || !n.Upper.Inclusive
+	}
+
+	return false
+}
+
+// Contains returns whether the given version falls within the interval by
+// precedence.
+func (i *Interval) Contains(v *semver.Version) bool {
+	if !i.Lower.Unbounded {
+		if cmp := v.Compare(&i.Lower.Version); cmp < 0 || cmp == 0 && !i.Lower.Inclusive {
+			return false
+		}
+	}
+
+	if !i.Upper.Unbounded {
+		if cmp := v.Compare(&i.Upper.Version); cmp > 0 || cmp == 0 && !i.Upper.Inclusive {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Range returns the interval as a range of at most two comparators.
+//
+// Bounds are rendered in a canonical form: a bound falling immediately after a
+// version is rendered relative to that version, so ">=1.2.4-0" is rendered as
+// ">1.2.3", and "<1.2.4-0" as "<=1.2.3".
+func (i *Interval) Range() Range {
+	if i.IsEmpty() {
+		return Range{{OpLess, minVersion}}
+	}
+
+	n := i.normalize()
+	i = &n
+
+	if !i.Lower.Unbounded && !i.Upper.Unbounded && i.Lower.Version.Compare(&i.Upper.Version) == 0 {
+		return Range{{OpEqual, i.Lower.Version}}
+	}
+
+	out := Range{}
+
+	if !i.Lower.Unbounded {
+		op := OpGreater
+		if i.Lower.Inclusive {
+			op = OpGreaterEqual
+		}
+		out = append(out, Comparator{op, i.Lower.Version})
+	}
+
+	if !i.Upper.Unbounded {
+		op := OpLess
+		if i.Upper.Inclusive {
+			op = OpLessEqual
+		}
+		out = append(out, Comparator{op, i.Upper.Version})
+	}
+
+	return out
+}
+
+// String returns the canonical string form of the interval.
+func (i *Interval) String() string {
+	return i.Range().String()
+}
+
+// Intervals returns the set of versions satisfying the constraint as a sorted
+// list of disjoint, non-adjacent, non-empty intervals.  The list is empty if no
+// version satisfies the constraint.
+//
+// Intervals, and the set operations built on them, consider only version
+// precedence, as under PrereleaseInclude.  A constraint built from intervals
+// may therefore match prerelease versions differently under PrereleaseExclude
+// than the constraints it was computed from.
+func (c *Constraint) Intervals() []Interval {
+	out := make([]Interval, 0, len(c.Ranges))
+
+	for _, r := range c.Ranges {
+		if i := r.interval(); !i.IsEmpty() {
+			out = append(out, i)
+		}
+	}
+
+	return merge(out)
+}
+
+// Intersect returns a constraint satisfied by exactly the versions satisfying
+// both this constraint and the other.
+func (c *Constraint) Intersect(other *Constraint) *Constraint {
+	a, b := c.Intervals(), other.Intervals()
+
+	var out []Interval
+
+	for x := range a {
+		for y := range b {
+			if i := intersect(a[x], b[y]); !i.IsEmpty() {
+				out = append(out, i)
+			}
+		}
+	}
+
+	return fromIntervals(merge(out))
+}
+
+// Union returns a constraint satisfied by exactly the versions satisfying
+// either this constraint or the other.
+func (c *Constraint) Union(other *Constraint) *Constraint {
+	return fromIntervals(merge(append(c.Intervals(), other.Intervals()...)))
+}
+
+// Complement returns a constraint satisfied by exactly the versions not
+// satisfying this constraint.
+func (c *Constraint) Complement() *Constraint {
+	var out []Interval
+
+	gap := Interval{Lower: Bound{Unbounded: true}}
+
+	for _, i := range c.Intervals() {
+		if !i.Lower.Unbounded {
+			gap.Upper = Bound{Version: i.Lower.Version, Inclusive: !i.Lower.Inclusive}
+			if !gap.IsEmpty() {
+				out = append(out, gap.normalize())
+			}
+		}
+
+		if i.Upper.Unbounded {
+			return fromIntervals(out)
+		}
+
+		gap.Lower = Bound{Version: i.Upper.Version, Inclusive: !i.Upper.Inclusive}
+	}
+
+	gap.Upper = Bound{Unbounded: true}
+
+	return fromIntervals(append(out, gap.normalize()))
+}
+
+// IsEmpty returns whether no version satisfies this constraint.
+func (c *Constraint) IsEmpty() bool {
+	return len(c.Intervals()) == 0
+}
+
+// IsSubsetOf returns whether every version satisfying this constraint also
+// satisfies the other.
+func (c *Constraint) IsSubsetOf(other *Constraint) bool {
+	return c.Intersect(other.Complement()).IsEmpty()
+}
+
+// Simplify returns an equivalent constraint made up of the fewest possible
+// ranges of at most two comparators each, in ascending order.
+func (c *Constraint) Simplify() *Constraint {
+	return fromIntervals(c.Intervals())
+}
+
+// Canonical returns the string form of the simplified constraint.  Two
+// constraints satisfied by the same versions have the same canonical form.
+func (c *Constraint) Canonical() string {
+	return c.Simplify().String()
+}
+
+func fromIntervals(intervals []Interval) *Constraint {
+	if len(intervals) == 0 {
+		return &Constraint{Ranges: []Range{{{OpLess, minVersion}}}}
+	}
+
+	out := &Constraint{Ranges: make([]Range, len(intervals))}
+	for i := range intervals {
+		out.Ranges[i] = intervals[i].Range()
+	}
+
+	return out
+}
+
+// interval returns the interval of versions satisfying every comparator in the
+// range.
+func (r Range) interval() Interval {
+	out := Interval{Lower: Bound{Unbounded: true}, Upper: Bound{Unbounded: true}}
+
+	for _, c := range r {
+		out = intersect(out, c.interval())
+	}
+
+	return out
+}
+
+func (c *Comparator) interval() Interval {
+	v := semver.Version{Major: c.Version.Major, Minor: c.Version.Minor, Patch: c.Version.Patch,
+		Prerelease: c.Version.Prerelease}
+	out := Interval{Lower: Bound{Unbounded: true}, Upper: Bound{Unbounded: true}}
+
+	switch c.Operator {
+	case OpEqual:
+		out.Lower = Bound{Version: v, Inclusive: true}
+		out.Upper = out.Lower
+	case OpLess, OpLessEqual:
+		out.Upper = Bound{Version: v, Inclusive: c.Operator == OpLessEqual}
+	case OpGreater, OpGreaterEqual:
+		out.Lower = Bound{Version: v, Inclusive: c.Operator == OpGreaterEqual}
+	}
+
+	return out.normalize()
+}
+
+// normalize returns the interval with each bound in canonical form, so that
+// equal sets of versions have equal bounds.
+//
+// Some versions have an immediate predecessor: 1.2.3 directly precedes
+// 1.2.4-0, and 1.2.3-rc directly precedes 1.2.3-rc.0.  An inclusive lower bound
+// or exclusive upper bound at such a version is replaced by an exclusive lower
+// bound or inclusive upper bound at its predecessor, and an inclusive lower
+// bound at the lowest version, 0.0.0-0, is replaced by an unbounded one.
+func (i Interval) normalize() Interval {
+	if !i.Lower.Unbounded && i.Lower.Inclusive {
+		if i.Lower.Version.Compare(&minVersion) == 0 {
+			i.Lower = Bound{Unbounded: true}
+		} else if prev, ok := predecessor(i.Lower.Version); ok {
+			i.Lower = Bound{Version: prev}
+		}
+	}
+
+	if !i.Upper.Unbounded && !i.Upper.Inclusive {
+		if prev, ok := predecessor(i.Upper.Version); ok {
+			i.Upper = Bound{Version: prev, Inclusive: true}
+		}
+	}
+
+	return i
+}
+
+func intersect(a, b Interval) Interval {
+	if compareLower(&a.Lower, &b.Lower) < 0 {
+		a.Lower = b.Lower
+	}
+
+	if compareUpper(&a.Upper, &b.Upper) > 0 {
+		a.Upper = b.Upper
+	}
+
+	return a
+}
+
+// merge sorts the given non-empty intervals and joins those that overlap or
+// are adjacent.
+func merge(in []Interval) []Interval {
+	if len(in) < 2 {
+		return in
+	}
+
+	sort.Slice(in, func(i, j int) bool {
+		return compareLower(&in[i].Lower, &in[j].Lower) < 0
+	})
+
+	out := in[:1]
+
+	for _, next := range in[1:] {
+		cur := &out[len(out)-1]
+
+		if !joins(&cur.Upper, &next.Lower) {
+			out = append(out, next)
+			continue
+		}
+
+		if compareUpper(&next.Upper, &cur.Upper) > 0 {
+			cur.Upper = next.Upper
+		}
+	}
+
+	return out
+}
+
+// joins returns whether an interval ending at the given upper bound overlaps
+// or is adjacent to an interval starting at the given lower bound, which is
+// not below the lower bound of the first interval.
+func joins(upper, lower *Bound) bool {
+	if upper.Unbounded || lower.Unbounded {
+		return true
+	}
+
+	switch cmp := lower.Version.Compare(&upper.Version); {
+	case cmp < 0:
+		return true
+	case cmp == 0:
+		return lower.Inclusive || upper.Inclusive
+	}
+
+	return false
+}
+
+// predecessor returns the version immediately preceding the given version in
+// precedence order, if one exists.  Only versions whose last prerelease
+// identifier is "0" have one: the predecessor of 1.2.3-rc.0 is 1.2.3-rc, and
+// that of 1.2.4-0 is 1.2.3.
+func predecessor(v semver.Version) (semver.Version, bool) {
+	n := len(v.Prerelease)
+
+	switch {
+	case n == 0 || v.Prerelease[n-1] != floor[0]:
+		return v, false
+	case n > 1:
+		pre := v.Prerelease[:n-1]
+		return semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Prerelease: pre[:len(pre):len(pre)]}, true
+	case v.Patch > 0:
+		return semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}, true
+	}
+
+	return v, false
+}
+
+func compareLower(a, b *Bound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return -1
+	case b.Unbounded:
+		return 1
+	}
+
+	if cmp := a.Version.Compare(&b.Version); cmp != 0 {
+		return cmp
+	}
+
+	return compareInclusive(b.Inclusive, a.Inclusive)
+}
+
+func compareUpper(a, b *Bound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return 1
+	case b.Unbounded:
+		return -1
+	}
+
+	if cmp := a.Version.Compare(&b.Version); cmp != 0 {
+		return cmp
+	}
+
+	return compareInclusive(a.Inclusive, b.Inclusive)
+}
+
+func compareInclusive(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+
+	return -1
+}
@@ -0,0 +1,181 @@
+package constraint_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+func TestConstraint_Canonical(t *testing.T) {
+	tests := map[string]string{
+		"^1.2.3":                           ">=1.2.3 <2.0.0-0",
+		">=1.0.0 >=1.2.0 <3.0.0 <2.0.0":    ">=1.2.0 <2.0.0",
+		"^2 || ^1":                         ">=1.0.0 <2.0.0-0 || >=2.0.0 <3.0.0-0",
+		">=2.0.0-0 <3 || ^1":               ">=1.0.0 <3.0.0-0",
+		"1.2.3 - 1.4.0 || 1.3.0 - 1.6.0":   ">=1.2.3 <=1.6.0",
+		"<=1.2.3 || >=1.2.4-0":             "*",
+		"<1.0.0 || 1.0.0":                  "<=1.0.0",
+		"<1.0.0 || >1.0.0":                 "<1.0.0 || >1.0.0",
+		"<=1.0.0-rc || >=1.0.0-rc.0 <2":    "<2.0.0-0",
+		">=1.0.0 <=1.0.0":                  "1.0.0",
+		">=2.0.0 <1.0.0":                   "<0.0.0-0",
+		">1.2.3":                           ">1.2.3",
+		">=1.2.4-0":                        ">1.2.3",
+		"<1.2.4-0":                         "<=1.2.3",
+		">=1.0.0-rc.0 <1.0.0-rc.1.0":       ">1.0.0-rc <=1.0.0-rc.1",
+		">1.2.3 <1.2.4-0":                  "<0.0.0-0",
+		">=0.0.0-0":                        "*",
+		"*":                                "*",
+		">1.0.0+build <2.0.0":              ">1.0.0 <2.0.0",
+		">=3.0.0 || <1.0.0 || ~2.1 || 2.0": "<1.0.0 || >=2.0.0 <2.1.0-0 || >=2.1.0 <2.2.0-0 || >=3.0.0",
+		"2.1.0-0 - 2.1.5 || 2.0":           ">=2.0.0 <=2.1.5",
+	}
+
+	for input, expect := range tests {
+		t.Run(input, func(t *testing.T) {
+			c := constraint.MustParseConstraint(input)
+
+			if got := c.Canonical(); got != expect {
+				t.Errorf("Expected %s, got %s", expect, got)
+			}
+		})
+	}
+}
+
+func TestConstraint_Intersect(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		expect string
+	}{
+		{"^1.2", "~1.4.1", ">=1.4.1 <1.5.0-0"},
+		{"^1.2", "^2", "<0.0.0-0"},
+		{">=1.0.0 <=2.0.0", ">=2.0.0", "2.0.0"},
+		{"<1.0.0 || >=2.0.0", "*", "<1.0.0 || >=2.0.0"},
+		{"1.x || 3.x", "^1.5 || ^2 || 3.1.x", ">=1.5.0 <2.0.0-0 || >=3.1.0 <3.2.0-0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" & "+test.b, func(t *testing.T) {
+			a := constraint.MustParseConstraint(test.a)
+			b := constraint.MustParseConstraint(test.b)
+
+			if got := a.Intersect(b).String(); got != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, got)
+			}
+
+			if got := b.Intersect(a).String(); got != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestConstraint_Union(t *testing.T) {
+	a := constraint.MustParseConstraint("^1.2")
+	b := constraint.MustParseConstraint(">=1.8.0 <3.0.0")
+
+	if got, expect := a.Union(b).String(), ">=1.2.0 <3.0.0"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+}
+
+func TestConstraint_Complement(t *testing.T) {
+	tests := map[string]string{
+		"^1.2.3":            "<1.2.3 || >=2.0.0-0",
+		"*":                 "<0.0.0-0",
+		"<0.0.0-0":          "*",
+		"1.0.0":             "<1.0.0 || >1.0.0",
+		"<1.0.0 || >=2.0.0": ">=1.0.0 <2.0.0",
+		">=1.0.0":           "<1.0.0",
+	}
+
+	for input, expect := range tests {
+		t.Run(input, func(t *testing.T) {
+			c := constraint.MustParseConstraint(input)
+
+			if got := c.Complement().String(); got != expect {
+				t.Errorf("Expected %s, got %s", expect, got)
+			}
+
+			if got, orig := c.Complement().Complement().String(), c.Canonical(); got != orig {
+				t.Errorf("Expected double complement %s, got %s", orig, got)
+			}
+		})
+	}
+}
+
+func TestConstraint_IsSubsetOf(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		expect bool
+	}{
+		{"~1.2.3", "^1.2", true},
+		{"^1.2", "~1.2.3", false},
+		{"1.2.3", ">=1.0.0 <2.0.0", true},
+		{"<0.0.0-0", "1.0.0", true},
+		{"^1 || ^2", ">=1.0.0 <3.0.0-0", true},
+		{"^1 || ^3", ">=1.0.0 <3.0.0-0", false},
+		{">1.2.3", ">=1.2.4-0", true},
+		{">=1.2.4-0", ">1.2.3", true},
+		{"<1.2.4-0", "<=1.2.3", true},
+		{"<=1.2.3", "<1.2.4-0", true},
+		{">1.2.3", ">=1.2.4", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" in "+test.b, func(t *testing.T) {
+			a := constraint.MustParseConstraint(test.a)
+			b := constraint.MustParseConstraint(test.b)
+
+			if got := a.IsSubsetOf(b); got != test.expect {
+				t.Errorf("Expected %t, got %t", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestConstraint_IsEmpty(t *testing.T) {
+	tests := map[string]bool{
+		"*":                     false,
+		"<0.0.0-0":              true,
+		">2.0.0 <2.0.0":         true,
+		">=2.0.0 <=2.0.0":       false,
+		">2.0.0 || <1.0.0":      false,
+		"^1 >=2.0.0":            true,
+		">1.2.3 <1.2.4-0":       true,
+		">1.2.3 <=1.2.4-0":      false,
+		">1.0.0-rc <1.0.0-rc.0": true,
+	}
+
+	for input, empty := range tests {
+		t.Run(input, func(t *testing.T) {
+			if got := constraint.MustParseConstraint(input).IsEmpty(); got != empty {
+				t.Errorf("Expected %t, got %t", empty, got)
+			}
+		})
+	}
+}
+
+func TestConstraint_Intervals(t *testing.T) {
+	a := constraint.MustParseConstraint("^1.2")
+	b := constraint.MustParseConstraint(">=1.4.0 || <1.0.0")
+
+	got := a.Intersect(b).Intervals()
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 interval, got %d", len(got))
+	}
+
+	i := got[0]
+	if i.Lower.Unbounded || !i.Lower.Inclusive || i.Lower.Version.String() != "1.4.0" {
+		t.Errorf("Expected lower bound >=1.4.0, got %+v", i.Lower)
+	}
+
+	if i.Upper.Unbounded || i.Upper.Inclusive || i.Upper.Version.String() != "2.0.0-0" {
+		t.Errorf("Expected upper bound <2.0.0-0, got %+v", i.Upper)
+	}
+
+	v := i.Lower.Version
+	if !i.Contains(&v) {
+		t.Errorf("Expected interval to contain %s", v.String())
+	}
+}
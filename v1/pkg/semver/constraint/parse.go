@@ -0,0 +1,249 @@
+package constraint
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// SyntaxError is returned by ParseConstraint when the input could not be
+// parsed.
+type SyntaxError struct {
+	// Input is the full constraint string.
+	Input string
+
+	// Token is the part of the input that could not be parsed.
+	Token string
+
+	// Msg describes the problem.
+	Msg string
+
+	// Err is the underlying version parsing error, if any.
+	Err error
+}
+
+func (s *SyntaxError) Error() string {
+	out := "invalid constraint " + strconv.Quote(s.Input) + ": " + s.Msg
+
+	if s.Token != "" {
+		out += " " + strconv.Quote(s.Token)
+	}
+
+	if s.Err != nil {
+		out += ": " + s.Err.Error()
+	}
+
+	return out
+}
+
+func (s *SyntaxError) Unwrap() error {
+	return s.Err
+}
+
+// ParseConstraint parses the given constraint string.
+//
+// A constraint is made up of one or more ranges separated by "||".  Each range
+// is a whitespace separated list of comparators, all of which must be satisfied.
+// The following forms are supported:
+//
+//   =1.2.3, 1.2.3      Exactly 1.2.3
+//   >1.2.3, >=1.2.3    Greater than (or equal to) 1.2.3
+//   <1.2.3, <=1.2.3    Less than (or equal to) 1.2.3
+//   1.x, 1.2.*, 1, *   Any version matching the given components
+//   ~1.2.3             Patch level changes: >=1.2.3 <1.3.0-0
+//   ^1.2.3             Changes that do not modify the left-most nonzero
+//                      component: >=1.2.3 <2.0.0-0
+//   1.2.3 - 1.4.0      Inclusive range: >=1.2.3 <=1.4.0
+//
+// Versions may carry a leading 'v' character.
+func ParseConstraint(constraint string) (*Constraint, error) {
+	out := new(Constraint)
+
+	for _, raw := range strings.Split(constraint, "||") {
+		r, err := parseRange(constraint, raw)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Ranges = append(out.Ranges, r)
+	}
+
+	return out, nil
+}
+
+// MustParseConstraint is the same as ParseConstraint but panics if the input
+// could not be parsed.
+func MustParseConstraint(constraint string) *Constraint {
+	out, err := ParseConstraint(constraint)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+func parseRange(input, raw string) (Range, error) {
+	tokens := joinOperators(strings.Fields(raw))
+
+	if len(tokens) == 3 && tokens[1] == "-" {
+		return parseHyphen(input, tokens[0], tokens[2])
+	}
+
+	out := Range{}
+
+	for _, tok := range tokens {
+		if tok == "-" {
+			return nil, &SyntaxError{Input: input, Token: raw, Msg: "malformed hyphen range"}
+		}
+
+		comps, err := parseComparator(input, tok)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, comps...)
+	}
+
+	return out, nil
+}
+
+// joinOperators merges operators separated from their version by whitespace,
+// such as ">= 1.2.3", into a single token.
+func joinOperators(tokens []string) []string {
+	out := tokens[:0]
+
+	for i := 0; i < len(tokens); i++ {
+		if isOperator(tokens[i]) && i+1 < len(tokens) {
+			out = append(out, tokens[i]+tokens[i+1])
+			i++
+		} else {
+			out = append(out, tokens[i])
+		}
+	}
+
+	return out
+}
+
+func isOperator(tok string) bool {
+	switch tok {
+	case "=", "<", "<=", ">", ">=", "~", "~>", "^":
+		return true
+	}
+
+	return false
+}
+
+func splitOperator(tok string) (op, rest string) {
+	for _, prefix := range [...]string{"~>", ">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(tok, prefix) {
+			return prefix, tok[len(prefix):]
+		}
+	}
+
+	return "", tok
+}
+
+func parseComparator(input, tok string) (Range, error) {
+	op, rest := splitOperator(tok)
+
+	p, err := parsePartial(input, rest)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "~", "~>":
+		return tildeRange(p), nil
+	case "^":
+		return caretRange(p), nil
+	case ">":
+		return greaterRange(p, false), nil
+	case ">=":
+		return greaterRange(p, true), nil
+	case "<":
+		return lessRange(p, false), nil
+	case "<=":
+		return lessRange(p, true), nil
+	}
+
+	return xRange(p), nil
+}
+
+func parseHyphen(input, lo, hi string) (Range, error) {
+	from, err := parsePartial(input, lo)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parsePartial(input, hi)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(greaterRange(from, true), lessRange(to, true)...), nil
+}
+
+// partial is a possibly incomplete version, such as "1.2" or "1.x".
+type partial struct {
+	version semver.Version
+
+	// set is the number of leading numeric components that were provided.
+	set int
+}
+
+func parsePartial(input, tok string) (p partial, err error) {
+	if len(tok) > 0 && (tok[0] == 'v' || tok[0] == 'V') {
+		tok = tok[1:]
+	}
+
+	if tok == "" {
+		return p, &SyntaxError{Input: input, Msg: "missing version"}
+	}
+
+	end := strings.IndexAny(tok, "-+")
+	if end < 0 {
+		end = len(tok)
+	}
+
+	segs := strings.Split(tok[:end], ".")
+	if len(segs) > 3 {
+		return p, &SyntaxError{Input: input, Token: tok, Msg: "too many version components"}
+	}
+
+	nums := [3]*uint64{&p.version.Major, &p.version.Minor, &p.version.Patch}
+
+	for i, seg := range segs {
+		if isWildcard(seg) {
+			break
+		}
+
+		if *nums[i], err = strconv.ParseUint(seg, 10, 64); err != nil {
+			return p, &SyntaxError{Input: input, Token: tok, Msg: "invalid version", Err: err}
+		}
+
+		p.set++
+	}
+
+	for _, seg := range segs[p.set:] {
+		if !isWildcard(seg) {
+			return p, &SyntaxError{Input: input, Token: tok, Msg: "version component follows a wildcard"}
+		}
+	}
+
+	if end < len(tok) {
+		if p.set < 3 {
+			return p, &SyntaxError{Input: input, Token: tok, Msg: "prerelease or build on a partial version"}
+		}
+
+		if p.version, err = semver.ParseStrict(tok); err != nil {
+			return p, &SyntaxError{Input: input, Token: tok, Msg: "invalid version", Err: err}
+		}
+	}
+
+	return p, nil
+}
+
+func isWildcard(seg string) bool {
+	return seg == "x" || seg == "X" || seg == "*"
+}
@@ -0,0 +1,87 @@
+package constraint_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := [][2]string{
+		{"1.2.3", "1.2.3"},
+		{"=v1.2.3", "1.2.3"},
+		{">=1.2.3 <2.0.0", ">=1.2.3 <2.0.0"},
+		{">= 1.2.3  < 2.0.0", ">=1.2.3 <2.0.0"},
+		{"*", "*"},
+		{"", "*"},
+		{"1.x", ">=1.0.0 <2.0.0-0"},
+		{"1.2.*", ">=1.2.0 <1.3.0-0"},
+		{"1", ">=1.0.0 <2.0.0-0"},
+		{">1", ">=2.0.0"},
+		{">1.2", ">=1.3.0"},
+		{"<1.2", "<1.2.0-0"},
+		{"<=1.2", "<1.3.0-0"},
+		{"~1.2.3", ">=1.2.3 <1.3.0-0"},
+		{"~>1.2.3", ">=1.2.3 <1.3.0-0"},
+		{"~1.2", ">=1.2.0 <1.3.0-0"},
+		{"~1", ">=1.0.0 <2.0.0-0"},
+		{"~1.2.3-beta.2", ">=1.2.3-beta.2 <1.3.0-0"},
+		{"^1.2.3", ">=1.2.3 <2.0.0-0"},
+		{"^1.4", ">=1.4.0 <2.0.0-0"},
+		{"^0.2.3", ">=0.2.3 <0.3.0-0"},
+		{"^0.0.3", ">=0.0.3 <0.0.4-0"},
+		{"^0.0", ">=0.0.0 <0.1.0-0"},
+		{"^0.x", ">=0.0.0 <1.0.0-0"},
+		{"1.2.3 - 1.4.0", ">=1.2.3 <=1.4.0"},
+		{"1.2 - 2.3", ">=1.2.0 <2.4.0-0"},
+		{"^1.2 || ~3.4.5 || 7.0.0", ">=1.2.0 <2.0.0-0 || >=3.4.5 <3.5.0-0 || 7.0.0"},
+		{"^18446744073709551615.0.0", ">=18446744073709551615.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test[0], func(t *testing.T) {
+			c, err := constraint.ParseConstraint(test[0])
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if c.String() != test[1] {
+				t.Errorf("Expected %q, got %q", test[1], c.String())
+			}
+		})
+	}
+}
+
+func TestParseConstraint_invalid(t *testing.T) {
+	tests := []string{
+		">=",
+		"1.2.3.4",
+		"1.x.3",
+		"1.2-beta",
+		"a.b.c",
+		"1.2.3-01",
+		"1.2.3 - ",
+		"1 - 2 - 3",
+		">=1.0.0 ||| <2",
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			_, err := constraint.ParseConstraint(test)
+
+			var se *constraint.SyntaxError
+			if !errors.As(err, &se) {
+				t.Fatalf("Expected a *SyntaxError, got %#v", err)
+			}
+		})
+	}
+}
+
+func TestParseConstraint_versionError(t *testing.T) {
+	_, err := constraint.ParseConstraint("^1.2.3-01")
+
+	if !errors.Is(err, semver.ReasonLeadingZero) {
+		t.Errorf("Expected a leading zero error, got %s", err)
+	}
+}
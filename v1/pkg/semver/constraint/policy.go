@@ -0,0 +1,54 @@
+package constraint
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// PrereleasePolicy controls how versions carrying prerelease identifiers are
+// matched against a constraint.
+type PrereleasePolicy uint8
+
+const (
+	// PrereleaseExclude follows npm semantics, and is the policy used by
+	// Constraint.Check and Constraint.Validate.
+	//
+	// A prerelease version only satisfies a range if it satisfies every
+	// comparator in that range, and at least one comparator in that range has
+	// a prerelease version with the same major, minor, and patch numbers.  This
+	// means "^1.2.3-beta.2" matches "1.2.3-beta.4" but not "1.2.4-beta.1", and
+	// "^1.2.3" matches neither.
+	PrereleaseExclude PrereleasePolicy = iota
+
+	// PrereleaseInclude matches prerelease versions exactly as any other version
+	// by their precedence, so "^1.2.3" matches "1.2.4-beta.1".
+	PrereleaseInclude
+)
+
+func (p PrereleasePolicy) String() string {
+	switch p {
+	case PrereleaseExclude:
+		return "exclude"
+	case PrereleaseInclude:
+		return "include"
+	}
+
+	return "unknown"
+}
+
+// allows returns whether the policy permits the given version to match the
+// given range, assuming the version satisfies each of the range's comparators.
+func (p PrereleasePolicy) allows(r Range, v *semver.Version) bool {
+	if p == PrereleaseInclude || len(v.Prerelease) == 0 {
+		return true
+	}
+
+	for i := range r {
+		c := &r[i].Version
+
+		if len(c.Prerelease) > 0 && c.Major == v.Major && c.Minor == v.Minor && c.Patch == v.Patch {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package constraint_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+func TestConstraint_CheckWith(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		exclude    bool
+		include    bool
+	}{
+		{"^1.2.3", "1.2.4-beta.1", false, true},
+		{"^1.2.3", "1.2.4", true, true},
+		{"^1.2.3-beta.2", "1.2.3-beta.4", true, true},
+		{"^1.2.3-beta.2", "1.2.3-beta.1", false, false},
+		{"^1.2.3-beta.2", "1.2.4-beta.1", false, true},
+		{">1.2.3-alpha <2", "1.2.3-beta", true, true},
+		{"1.x", "1.3.0-beta", false, true},
+		{"*", "1.0.0-rc.1", false, true},
+		{"<1.0.0 || 1.3.0-beta.1", "1.3.0-beta.1", true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.constraint+" "+test.version, func(t *testing.T) {
+			c := constraint.MustParseConstraint(test.constraint)
+			v, _ := semver.ParseStrict(test.version)
+
+			if c.CheckWith(&v, constraint.PrereleaseExclude) != test.exclude {
+				t.Errorf("Expected %t under exclude policy", test.exclude)
+			}
+			if c.Check(&v) != test.exclude {
+				t.Errorf("Expected Check to use the exclude policy")
+			}
+			if c.CheckWith(&v, constraint.PrereleaseInclude) != test.include {
+				t.Errorf("Expected %t under include policy", test.include)
+			}
+		})
+	}
+}
+
+func TestConstraint_ValidateWith(t *testing.T) {
+	c := constraint.MustParseConstraint("^1.2.3 || 3.x")
+	v, _ := semver.ParseStrict("1.3.0-rc.1")
+
+	err := c.ValidateWith(&v, constraint.PrereleaseExclude)
+
+	expect := `1.3.0-rc.1 does not satisfy ">=1.2.3 <2.0.0-0 || >=3.0.0 <4.0.0-0": ` +
+		`not >=3.0.0; prerelease not permitted by range`
+	if err == nil || err.Error() != expect {
+		t.Errorf("Expected %q, got %v", expect, err)
+	}
+
+	if err := c.ValidateWith(&v, constraint.PrereleaseInclude); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+}
@@ -0,0 +1,89 @@
+package conventional
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/gittag"
+)
+
+// RepoCommits returns the commits reachable from "to" but not from "from" in
+// the local git repository at the given path, oldest first, by running the
+// local git executable.
+//
+// If from is empty, every commit reachable from "to" is returned.  If to is
+// empty, "HEAD" is used.  Revisions starting with '-' are rejected, as git
+// would read them as options.
+func RepoCommits(path, from, to string) ([]Commit, error) {
+	if to == "" {
+		to = "HEAD"
+	}
+
+	for _, rev := range []string{from, to} {
+		if strings.HasPrefix(rev, "-") {
+			return nil, errors.New("conventional: invalid revision " + rev)
+		}
+	}
+
+	rng := to
+	if from != "" {
+		rng = from + ".." + to
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", "-C", path, "log", "--reverse", "--format=%H%x00%B%x00", rng, "--")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.New("conventional: git log " + rng + ": " + err.Error() + ": " +
+			strings.TrimSpace(stderr.String()))
+	}
+
+	var out []Commit
+
+	fields := strings.Split(stdout.String(), "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		out = append(out, Commit{
+			Hash:    strings.TrimSpace(fields[i]),
+			Message: strings.TrimSpace(fields[i+1]),
+		})
+	}
+
+	return out, nil
+}
+
+// NextFromRepo computes the next version of the project in the local git
+// repository at the given path.
+//
+// The current version is taken from the latest release tag with the given
+// prefix, as found by gittag.Repository.LatestRelease, and the commits from
+// that tag to HEAD are considered.  If no release tag exists, the current
+// version is 0.0.0 and every commit reachable from HEAD is considered.
+func NextFromRepo(path, prefix string) (Result, error) {
+	repo, err := gittag.OpenExec(path)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var current semver.Version
+	from := ""
+
+	if tag, err := repo.LatestRelease(prefix); err == nil {
+		current = tag.Version
+		from = tag.Commit
+	} else if err != gittag.ErrNotFound {
+		return Result{}, err
+	}
+
+	commits, err := RepoCommits(path, from, "HEAD")
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Next(current, commits)
+}
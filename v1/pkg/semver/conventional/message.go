@@ -0,0 +1,104 @@
+// Package conventional computes the next semantic version of a project from
+// commit messages following the Conventional Commits specification.
+//
+// Commits of type "feat" bump the minor version, commits of type "fix" bump
+// the patch version, and commits marked as breaking with a "!" after their
+// type or a "BREAKING CHANGE:" footer bump the major version.  While the major
+// version is 0, breaking changes bump the minor version instead.
+package conventional
+
+import (
+	"strings"
+)
+
+// Message is a parsed conventional commit message.
+type Message struct {
+	// Type is the commit type, such as "feat" or "fix", in lower case.
+	Type string
+
+	// Scope is the optional scope given in parentheses after the type.
+	Scope string
+
+	// Breaking is true if the commit is marked as a breaking change.
+	Breaking bool
+
+	// Description is the text following the type and scope on the first line.
+	Description string
+
+	// Body is the remainder of the message following the first line.
+	Body string
+}
+
+// ParseMessage parses the given commit message.  Returns false if the message
+// does not begin with a conventional commit header of the form
+// "type(scope)!: description".
+func ParseMessage(msg string) (Message, bool) {
+	var out Message
+
+	header := msg
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		header, out.Body = msg[:i], strings.TrimSpace(msg[i+1:])
+	}
+
+	colon := strings.Index(header, ": ")
+	if colon < 0 {
+		return out, false
+	}
+
+	prefix := header[:colon]
+	out.Description = strings.TrimSpace(header[colon+2:])
+
+	if strings.HasSuffix(prefix, "!") {
+		out.Breaking = true
+		prefix = prefix[:len(prefix)-1]
+	}
+
+	if open := strings.IndexByte(prefix, '('); open >= 0 {
+		if !strings.HasSuffix(prefix, ")") {
+			return out, false
+		}
+
+		out.Scope = prefix[open+1 : len(prefix)-1]
+		prefix = prefix[:open]
+	}
+
+	if prefix == "" || out.Description == "" || !isWord(prefix) {
+		return out, false
+	}
+
+	out.Type = strings.ToLower(prefix)
+
+	for _, line := range strings.Split(out.Body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			out.Breaking = true
+			break
+		}
+	}
+
+	return out, true
+}
+
+// Bump returns the kind of version increment the message calls for.
+func (m *Message) Bump() Bump {
+	switch {
+	case m.Breaking:
+		return BumpMajor
+	case m.Type == "feat":
+		return BumpMinor
+	case m.Type == "fix":
+		return BumpPatch
+	}
+
+	return BumpNone
+}
+
+func isWord(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,80 @@
+package conventional_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver/conventional"
+)
+
+func TestParseMessage(t *testing.T) {
+	tests := map[string]struct {
+		msg  string
+		ok   bool
+		want conventional.Message
+		bump conventional.Bump
+	}{
+		"feature": {
+			msg:  "feat: add thing",
+			ok:   true,
+			want: conventional.Message{Type: "feat", Description: "add thing"},
+			bump: conventional.BumpMinor,
+		},
+		"scoped fix": {
+			msg:  "fix(parser): handle empty input\n\nlonger explanation",
+			ok:   true,
+			want: conventional.Message{Type: "fix", Scope: "parser", Description: "handle empty input", Body: "longer explanation"},
+			bump: conventional.BumpPatch,
+		},
+		"bang": {
+			msg:  "refactor(api)!: drop method",
+			ok:   true,
+			want: conventional.Message{Type: "refactor", Scope: "api", Breaking: true, Description: "drop method"},
+			bump: conventional.BumpMajor,
+		},
+		"footer": {
+			msg:  "feat: rework\n\nbody\n\nBREAKING CHANGE: everything",
+			ok:   true,
+			want: conventional.Message{Type: "feat", Breaking: true, Description: "rework", Body: "body\n\nBREAKING CHANGE: everything"},
+			bump: conventional.BumpMajor,
+		},
+		"hyphen footer": {
+			msg:  "fix: x\n\nBREAKING-CHANGE: y",
+			ok:   true,
+			want: conventional.Message{Type: "fix", Breaking: true, Description: "x", Body: "BREAKING-CHANGE: y"},
+			bump: conventional.BumpMajor,
+		},
+		"upper type": {
+			msg:  "FEAT: shout",
+			ok:   true,
+			want: conventional.Message{Type: "feat", Description: "shout"},
+			bump: conventional.BumpMinor,
+		},
+		"chore":          {msg: "chore: tidy", ok: true, want: conventional.Message{Type: "chore", Description: "tidy"}},
+		"plain":          {msg: "Update readme"},
+		"no space":       {msg: "feat:thing"},
+		"no description": {msg: "feat: "},
+		"bad scope":      {msg: "feat(x: thing"},
+		"bad type":       {msg: "Merge branch 'a b': thing"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := conventional.ParseMessage(test.msg)
+			if ok != test.ok {
+				t.Fatalf("Expected ok %t, got %t", test.ok, ok)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got != test.want {
+				t.Errorf("Expected %+v, got %+v", test.want, got)
+			}
+
+			if got.Bump() != test.bump {
+				t.Errorf("Expected bump %s, got %s", test.bump, got.Bump())
+			}
+		})
+	}
+}
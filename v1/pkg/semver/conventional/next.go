@@ -0,0 +1,118 @@
+package conventional
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Bump is a kind of version increment.
+type Bump uint8
+
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+var bumpNames = [...]string{"none", "patch", "minor", "major"}
+
+func (b Bump) String() string {
+	if int(b) < len(bumpNames) {
+		return bumpNames[b]
+	}
+
+	return "unknown"
+}
+
+// Commit is a single commit to be considered when computing the next version.
+type Commit struct {
+	// Hash optionally identifies the commit.
+	Hash string
+
+	// Message is the full commit message.
+	Message string
+}
+
+// Change is a commit that called for a version increment.
+type Change struct {
+	Commit  Commit
+	Message Message
+
+	// Bump is the increment called for by this commit alone.
+	Bump Bump
+}
+
+// Result is the outcome of computing the next version.
+type Result struct {
+	// Current is the version the computation started from.
+	Current semver.Version
+
+	// Next is the computed next version.  If no commits called for a version
+	// increment, this is equal to Current.
+	Next semver.Version
+
+	// Bump is the increment applied to Current to produce Next.  This may be
+	// lower than the highest increment called for by a commit under the pre-1.0
+	// rule.
+	Bump Bump
+
+	// Changes holds each commit that called for a version increment, in the
+	// order they were given.
+	Changes []Change
+}
+
+// ChangesFor returns the commits that called for the given kind of increment.
+func (r *Result) ChangesFor(bump Bump) []Change {
+	var out []Change
+
+	for _, c := range r.Changes {
+		if c.Bump == bump {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
+// Next computes the version following current based on the given commits.
+//
+// Commits that are not conventional commits, or whose type does not call for
+// an increment, are ignored.  While the major version of current is 0,
+// breaking changes increment the minor version rather than the major version.
+func Next(current semver.Version, commits []Commit) (Result, error) {
+	out := Result{Current: current, Next: current}
+	highest := BumpNone
+
+	for _, c := range commits {
+		msg, ok := ParseMessage(c.Message)
+		if !ok {
+			continue
+		}
+
+		if b := msg.Bump(); b > BumpNone {
+			out.Changes = append(out.Changes, Change{Commit: c, Message: msg, Bump: b})
+
+			if b > highest {
+				highest = b
+			}
+		}
+	}
+
+	out.Bump = highest
+	if current.Major == 0 && highest == BumpMajor {
+		out.Bump = BumpMinor
+	}
+
+	var err error
+
+	switch out.Bump {
+	case BumpMajor:
+		out.Next, err = current.NextMajor()
+	case BumpMinor:
+		out.Next, err = current.NextMinor()
+	case BumpPatch:
+		out.Next, err = current.NextPatch()
+	}
+
+	return out, err
+}
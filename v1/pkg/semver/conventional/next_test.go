@@ -0,0 +1,187 @@
+package conventional_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/conventional"
+)
+
+func commits(msgs ...string) []conventional.Commit {
+	out := make([]conventional.Commit, len(msgs))
+	for i, msg := range msgs {
+		out[i] = conventional.Commit{Hash: string(rune('a' + i)), Message: msg}
+	}
+	return out
+}
+
+func mustParse(t *testing.T, s string) semver.Version {
+	v, err := semver.Parse(s)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	return v
+}
+
+func TestNext(t *testing.T) {
+	tests := map[string]struct {
+		current string
+		msgs    []string
+		next    string
+		bump    conventional.Bump
+		changes int
+	}{
+		"none":          {"1.2.3", []string{"chore: x", "docs: y", "whatever"}, "1.2.3", conventional.BumpNone, 0},
+		"patch":         {"1.2.3", []string{"fix: x", "chore: y"}, "1.2.4", conventional.BumpPatch, 1},
+		"minor":         {"1.2.3", []string{"fix: x", "feat: y"}, "1.3.0", conventional.BumpMinor, 2},
+		"major":         {"1.2.3", []string{"fix: x", "feat!: y"}, "2.0.0", conventional.BumpMajor, 2},
+		"pre-1.0 major": {"0.4.1", []string{"feat!: y", "fix: z"}, "0.5.0", conventional.BumpMinor, 2},
+		"pre-1.0 minor": {"0.4.1", []string{"feat: y"}, "0.5.0", conventional.BumpMinor, 1},
+		"pre-1.0 patch": {"0.4.1", []string{"fix: y"}, "0.4.2", conventional.BumpPatch, 1},
+		"prerelease":    {"2.0.0-rc.1", []string{"fix: y"}, "2.0.0", conventional.BumpPatch, 1},
+		"empty":         {"1.0.0", nil, "1.0.0", conventional.BumpNone, 0},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := conventional.Next(mustParse(t, test.current), commits(test.msgs...))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if res.Next.String() != test.next {
+				t.Errorf("Expected %s, got %s", test.next, res.Next.String())
+			}
+
+			if res.Bump != test.bump {
+				t.Errorf("Expected bump %s, got %s", test.bump, res.Bump)
+			}
+
+			if len(res.Changes) != test.changes {
+				t.Errorf("Expected %d changes, got %d", test.changes, len(res.Changes))
+			}
+		})
+	}
+}
+
+func TestResult_ChangesFor(t *testing.T) {
+	res, err := conventional.Next(mustParse(t, "1.0.0"), commits("fix: a", "feat: b", "fix: c", "chore: d"))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	fixes := res.ChangesFor(conventional.BumpPatch)
+	if len(fixes) != 2 || fixes[0].Commit.Hash != "a" || fixes[1].Commit.Hash != "c" {
+		t.Errorf("Expected fixes a and c, got %+v", fixes)
+	}
+
+	feats := res.ChangesFor(conventional.BumpMinor)
+	if len(feats) != 1 || feats[0].Message.Description != "b" {
+		t.Errorf("Expected feature b, got %+v", feats)
+	}
+}
+
+func TestNext_Overflow(t *testing.T) {
+	_, err := conventional.Next(semver.Version{Major: 1, Minor: ^uint64(0)}, commits("feat: a"))
+	if err != semver.ErrOverflow {
+		t.Errorf("Expected %s, got %v", semver.ErrOverflow, err)
+	}
+}
+
+func TestNextFromRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not available")
+	}
+
+	dir := t.TempDir()
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"-c", "commit.gpgsign=false", "-c", "tag.gpgsign=false",
+			"-c", "init.defaultBranch=main",
+		}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_CONFIG_NOSYSTEM=1", "HOME="+dir)
+
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %s: %s", strings.Join(args, " "), err, out)
+		}
+	}
+
+	git("init", "-q")
+	git("commit", "--allow-empty", "-m", "feat: initial")
+
+	res, err := conventional.NextFromRepo(dir, "v")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if res.Next.String() != "0.1.0" {
+		t.Errorf("Expected 0.1.0, got %s", res.Next.String())
+	}
+
+	git("tag", "v1.4.0")
+	git("commit", "--allow-empty", "-m", "fix: one")
+	git("commit", "--allow-empty", "-m", "feat(cli): two\n\nBREAKING CHANGE: flags renamed")
+	git("commit", "--allow-empty", "-m", "chore: three")
+
+	res, err = conventional.NextFromRepo(dir, "v")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if res.Current.String() != "1.4.0" {
+		t.Errorf("Expected current 1.4.0, got %s", res.Current.String())
+	}
+	if res.Next.String() != "2.0.0" {
+		t.Errorf("Expected 2.0.0, got %s", res.Next.String())
+	}
+	if len(res.Changes) != 2 || res.Changes[0].Message.Description != "one" || res.Changes[1].Bump != conventional.BumpMajor {
+		t.Errorf("Expected fix and breaking feature, got %+v", res.Changes)
+	}
+}
+
+func TestRepoCommits_optionRevision(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not available")
+	}
+
+	dir := t.TempDir()
+	output := filepath.Join(dir, "out")
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"-c", "commit.gpgsign=false", "-c", "init.defaultBranch=main",
+		}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_CONFIG_NOSYSTEM=1", "HOME="+dir)
+
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %s: %s", strings.Join(args, " "), err, out)
+		}
+	}
+
+	git("init", "-q")
+	git("commit", "--allow-empty", "-m", "feat: initial")
+
+	tests := [][2]string{
+		{"--output=" + output, "HEAD"},
+		{"", "--output=" + output},
+		{"-p", ""},
+	}
+
+	for _, test := range tests {
+		if _, err := conventional.RepoCommits(dir, test[0], test[1]); err == nil {
+			t.Errorf("Expected an error for revisions %q..%q", test[0], test[1])
+		}
+	}
+
+	if matches, _ := filepath.Glob(output + "*"); len(matches) != 0 {
+		t.Errorf("Expected revisions to not be passed to git as options, found %v", matches)
+	}
+}
@@ -0,0 +1,167 @@
+package semver
+
+import (
+	"github.com/foxcapades/go-bytify/v0/bytify"
+)
+
+// ErrInvalidVersion is the sentinel error matched by every *ParseError when
+// using errors.Is.
+var ErrInvalidVersion error = sentinel("invalid semantic version string")
+
+// Component identifies a section of a semantic version string.
+type Component uint8
+
+const (
+	// ComponentNone is used for errors that do not relate to any specific
+	// component of the version string, such as an empty input.
+	ComponentNone Component = iota
+	ComponentMajor
+	ComponentMinor
+	ComponentPatch
+	ComponentPrerelease
+	ComponentBuild
+)
+
+var componentNames = [...]string{
+	"",
+	"major version",
+	"minor version",
+	"patch version",
+	"prerelease",
+	"build metadata",
+}
+
+func (c Component) String() string {
+	if int(c) < len(componentNames) {
+		return componentNames[c]
+	}
+
+	return "unknown component"
+}
+
+// Reason describes why a version string was rejected.
+//
+// Reason values are themselves errors, and may be used as sentinels with
+// errors.Is to test for a specific cause of a *ParseError.
+type Reason uint8
+
+const (
+	// ReasonEmpty indicates the input string was empty.
+	ReasonEmpty Reason = iota + 1
+
+	// ReasonMissingNumber indicates a numeric component was absent.
+	ReasonMissingNumber
+
+	// ReasonTooManyComponents indicates the input had more than 3 numeric
+	// components.
+	ReasonTooManyComponents
+
+	// ReasonInvalidCharacter indicates a character not permitted at the
+	// reported position was encountered.
+	ReasonInvalidCharacter
+
+	// ReasonLeadingZero indicates a numeric component or numeric prerelease
+	// identifier had a leading zero.
+	ReasonLeadingZero
+
+	// ReasonEmptyIdentifier indicates a prerelease or build identifier was
+	// empty.
+	ReasonEmptyIdentifier
+
+	// ReasonOverflow indicates a numeric component was too large to be held in
+	// a uint64.
+	ReasonOverflow
+
+	// ReasonTooLong indicates the input exceeded the maximum length configured
+	// on a Parser.
+	ReasonTooLong
+)
+
+var reasonMessages = [...]string{
+	"unknown error",
+	"empty version string",
+	"missing number",
+	"too many numeric components",
+	"invalid character",
+	"leading zero",
+	"empty identifier",
+	"numeric overflow",
+	"version string too long",
+}
+
+func (r Reason) Error() string {
+	if int(r) < len(reasonMessages) {
+		return reasonMessages[r]
+	}
+
+	return reasonMessages[0]
+}
+
+func (r Reason) String() string {
+	return r.Error()
+}
+
+// ParseError is returned when a version string could not be parsed.
+type ParseError struct {
+	// Offset is the byte offset into the input at which the error was found.
+	Offset int
+
+	// Char is the offending character, or 0 if the error was caused by the end
+	// of the input.
+	Char byte
+
+	// Component is the section of the version string being parsed when the
+	// error was found.
+	Component Component
+
+	// Reason describes why the input was rejected.
+	Reason Reason
+}
+
+func newParseError(in string, pos int, comp Component, reason Reason) *ParseError {
+	out := &ParseError{Offset: pos, Component: comp, Reason: reason}
+
+	if pos < len(in) {
+		out.Char = in[pos]
+	}
+
+	return out
+}
+
+func (p *ParseError) Error() string {
+	out := make([]byte, 0, 96)
+	out = append(out, ErrInvalidVersion.Error()...)
+	out = append(out, ':', ' ')
+	out = append(out, p.Reason.Error()...)
+
+	if p.Reason == ReasonInvalidCharacter && p.Char != 0 {
+		out = append(out, ' ', '\'', p.Char, '\'')
+	}
+
+	if p.Component != ComponentNone {
+		out = append(out, " in "...)
+		out = append(out, p.Component.String()...)
+	}
+
+	out = append(out, " at offset "...)
+	out = append(out, bytify.Uint64ToByteSlice(uint64(p.Offset))...)
+
+	return string(out)
+}
+
+// Is reports whether the given target is ErrInvalidVersion or this error's
+// Reason.
+func (p *ParseError) Is(target error) bool {
+	return target == ErrInvalidVersion || target == p.Reason
+}
+
+// Unwrap returns the Reason for this error.
+func (p *ParseError) Unwrap() error {
+	return p.Reason
+}
+
+type sentinel string
+
+func (s sentinel) Error() string {
+	return string(s)
+}
@@ -0,0 +1,64 @@
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input  string
+		strict bool
+		expect semver.ParseError
+	}{
+		{"", false, semver.ParseError{Reason: semver.ReasonEmpty}},
+		{"v1.x.3", false, semver.ParseError{3, 'x', semver.ComponentMinor, semver.ReasonInvalidCharacter}},
+		{"1.2.3.4", false, semver.ParseError{5, '.', semver.ComponentPatch, semver.ReasonTooManyComponents}},
+		{"1.2.03", true, semver.ParseError{4, '0', semver.ComponentPatch, semver.ReasonLeadingZero}},
+		{"1.2", true, semver.ParseError{3, 0, semver.ComponentPatch, semver.ReasonMissingNumber}},
+		{"1.2.3-a.", true, semver.ParseError{8, 0, semver.ComponentPrerelease, semver.ReasonEmptyIdentifier}},
+		{"1.2.3+a!", true, semver.ParseError{7, '!', semver.ComponentBuild, semver.ReasonInvalidCharacter}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var err error
+			if test.strict {
+				_, err = semver.ParseStrict(test.input)
+			} else {
+				_, err = semver.Parse(test.input)
+			}
+
+			var pe *semver.ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("Expected a *ParseError, got %#v", err)
+			}
+			if *pe != test.expect {
+				t.Errorf("Expected %#v, got %#v", test.expect, *pe)
+			}
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Error("Expected error to match ErrInvalidVersion")
+			}
+			if !errors.Is(err, test.expect.Reason) {
+				t.Errorf("Expected error to match %s", test.expect.Reason)
+			}
+		})
+	}
+}
+
+func TestParseError_coerce(t *testing.T) {
+	_, _, err := semver.Coerce("  =release-99999999999999999999 ")
+
+	var pe *semver.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected a *ParseError, got %#v", err)
+	}
+	if pe.Offset != 30 || pe.Reason != semver.ReasonOverflow {
+		t.Errorf("Unexpected error %s", pe)
+	}
+	if errors.Is(err, semver.ReasonEmpty) {
+		t.Error("Expected error not to match ReasonEmpty")
+	}
+}
@@ -0,0 +1,114 @@
+//go:build go1.18
+// +build go1.18
+
+package semver_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func fuzzSeed(f *testing.F) {
+	for _, list := range [][]string{conformanceValid, conformanceOverflow, conformanceInvalid} {
+		for _, s := range list {
+			f.Add(s)
+		}
+	}
+
+	f.Add("v1.2.3-alpha.v1+2020-09-18.b21")
+	f.Add("1.2.3-aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	f.Add("v")
+	f.Add("1..2")
+	f.Add("1.2.3-+")
+}
+
+func FuzzParse(f *testing.F) {
+	fuzzSeed(f)
+
+	f.Fuzz(func(t *testing.T, input string) {
+		first, err := semver.Parse(input)
+		if err != nil {
+			return
+		}
+
+		out := first.String()
+
+		second, err := semver.Parse(out)
+		if err != nil {
+			t.Fatalf("Parse(%q) produced %q which failed to parse: %s", input, out, err)
+		}
+
+		if second.String() != out || second.Compare(&first) != 0 || !second.Equal(&first) {
+			t.Fatalf("Parse(%q) round trip unstable: %q -> %q", input, out, second.String())
+		}
+
+		if v, err := semver.Parse(first.VString()); err != nil || v.String() != out {
+			t.Fatalf("VString of %q did not round trip", input)
+		}
+	})
+}
+
+func FuzzParseStrict(f *testing.F) {
+	fuzzSeed(f)
+
+	f.Fuzz(func(t *testing.T, input string) {
+		ver, err := semver.ParseStrict(input)
+		if err != nil {
+			return
+		}
+
+		if ver.String() != input {
+			t.Fatalf("ParseStrict(%q) round tripped to %q", input, ver.String())
+		}
+
+		if loose, err := semver.Parse(input); err != nil || loose.Compare(&ver) != 0 {
+			t.Fatalf("Parse disagreed with ParseStrict for %q", input)
+		}
+	})
+}
+
+func FuzzCoerce(f *testing.F) {
+	fuzzSeed(f)
+
+	f.Fuzz(func(t *testing.T, input string) {
+		ver, _, err := semver.Coerce(input)
+		if err != nil {
+			return
+		}
+
+		if _, err := semver.ParseStrict(ver.String()); err != nil {
+			t.Fatalf("Coerce(%q) produced invalid version %q: %s", input, ver.String(), err)
+		}
+	})
+}
+
+func FuzzSortKey(f *testing.F) {
+	f.Add("1.0.0-01", "1.0.0-1.x")
+	f.Add("1.0.0-00000000000000000000000000000000", "1.0.0+001")
+	f.Add("1.0.0-alpha", "1.0.0-alpha.1")
+
+	f.Fuzz(func(t *testing.T, a, b string) {
+		va, errA := semver.Parse(a)
+		vb, errB := semver.Parse(b)
+		if errA != nil || errB != nil {
+			return
+		}
+
+		ka, kb := va.AppendSortKey(nil), vb.AppendSortKey(nil)
+
+		if cmp := va.Compare(&vb); cmp != 0 && bytes.Compare(ka, kb) != cmp {
+			t.Fatalf("Sort keys of %q and %q do not match precedence", a, b)
+		}
+
+		var out semver.Version
+		if err := out.UnmarshalBinary(ka); err != nil {
+			t.Fatalf("Sort key of %q failed to decode: %s", a, err)
+		}
+
+		if out.String() != va.String() {
+			t.Fatalf("Sort key of %q round tripped to %q", a, out.String())
+		}
+	})
+}
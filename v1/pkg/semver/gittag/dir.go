@@ -0,0 +1,320 @@
+package gittag
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	tagsPrefix = "refs/tags/"
+
+	// maxPeelDepth limits how many nested annotated tags will be followed.
+	maxPeelDepth = 8
+)
+
+// Open reads the tags of the git repository at the given path by reading its
+// git directory directly, without running git.
+//
+// The path may be the root of a working tree, or the git directory itself.
+// Linked worktrees and submodules, whose .git entry is a file pointing to the
+// real git directory, are supported.
+//
+// Annotated tags are resolved to commits using the peeled entries of the
+// packed-refs file, or by reading tag objects from the loose objects and pack
+// files of the repository.  Objects stored only in alternate object
+// directories are not read, leaving the Commit of the tags pointing to them
+// empty.
+func Open(path string) (*Repository, error) {
+	gitDir, err := findGitDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	commonDir := gitDir
+	if raw, err := ioutil.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir = strings.TrimSpace(string(raw))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+	}
+
+	refs, err := readPackedRefs(commonDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := readLooseRefs(commonDir, refs); err != nil {
+		return nil, err
+	}
+
+	out := &Repository{refs: make([]ref, 0, len(refs))}
+	store := newObjectStore(commonDir)
+
+	for _, rf := range refs {
+		if rf.commit == "" {
+			rf.commit = peel(store, rf.object)
+		}
+		out.refs = append(out.refs, *rf)
+	}
+
+	out.head = resolveHead(gitDir, commonDir)
+
+	return out, nil
+}
+
+func findGitDir(path string) (string, error) {
+	dotGit := filepath.Join(path, ".git")
+
+	info, err := os.Stat(dotGit)
+	switch {
+	case err == nil && info.IsDir():
+		return dotGit, nil
+
+	case err == nil:
+		raw, err := ioutil.ReadFile(dotGit)
+		if err != nil {
+			return "", err
+		}
+
+		line := strings.TrimSpace(string(raw))
+		if !strings.HasPrefix(line, "gitdir:") {
+			return "", errors.New("gittag: malformed .git file " + dotGit)
+		}
+
+		dir := strings.TrimSpace(strings.TrimPrefix(line, "gitdir:"))
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(path, dir)
+		}
+
+		return dir, nil
+	}
+
+	// The path may be a git directory, such as a bare repository.
+	if _, err := os.Stat(filepath.Join(path, "HEAD")); err == nil {
+		return path, nil
+	}
+
+	return "", errors.New("gittag: not a git repository: " + path)
+}
+
+// readPackedRefs reads the tag refs from the packed-refs file in the given git
+// directory, if present.
+func readPackedRefs(gitDir string) (map[string]*ref, error) {
+	out := map[string]*ref{}
+
+	file, err := os.Open(filepath.Join(gitDir, "packed-refs"))
+	if os.IsNotExist(err) {
+		return out, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var last *ref
+
+	// When the file header declares the "peeled" trait, every annotated tag is
+	// followed by a peeled entry, so tags without one point directly to a
+	// commit.
+	peeled := false
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "# pack-refs with:"):
+			for _, trait := range strings.Fields(line[len("# pack-refs with:"):]) {
+				peeled = peeled || trait == "peeled" || trait == "fully-peeled"
+			}
+			continue
+
+		case line == "" || line[0] == '#':
+			continue
+
+		case line[0] == '^':
+			// Peeled entry for the preceding annotated tag.
+			if last != nil {
+				last.commit = line[1:]
+			}
+			continue
+		}
+
+		last = nil
+
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[1], tagsPrefix) {
+			continue
+		}
+
+		last = &ref{name: parts[1][len(tagsPrefix):], object: parts[0]}
+		out[last.name] = last
+	}
+
+	if peeled {
+		for _, rf := range out {
+			if rf.commit == "" {
+				rf.commit = rf.object
+			}
+		}
+	}
+
+	return out, scanner.Err()
+}
+
+// readLooseRefs reads the tag refs stored as files under refs/tags in the
+// given git directory, replacing any packed refs of the same name.
+func readLooseRefs(gitDir string, refs map[string]*ref) error {
+	root := filepath.Join(gitDir, "refs", "tags")
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		name = filepath.ToSlash(name)
+		refs[name] = &ref{name: name, object: strings.TrimSpace(string(raw))}
+
+		return nil
+	})
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// peel follows annotated tag objects from the given object hash to the commit
+// they point to.  Returns an empty string if an object could not be read.
+func peel(store *objectStore, object string) string {
+	for i := 0; i < maxPeelDepth; i++ {
+		kind, body, err := store.read(object)
+		if err != nil {
+			return ""
+		}
+
+		switch kind {
+		case "commit":
+			return object
+		case "tag":
+			object = tagTarget(body)
+		default:
+			return ""
+		}
+	}
+
+	return ""
+}
+
+// readLooseObject reads and decompresses the loose object with the given hash,
+// returning its type and content.
+func readLooseObject(gitDir, object string) (kind string, body []byte, err error) {
+	if len(object) < 3 {
+		return "", nil, errors.New("gittag: invalid object hash " + object)
+	}
+
+	file, err := os.Open(filepath.Join(gitDir, "objects", object[:2], object[2:]))
+	if err != nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	zr, err := zlib.NewReader(file)
+	if err != nil {
+		return "", nil, err
+	}
+	defer zr.Close()
+
+	raw, err := ioutil.ReadAll(io.LimitReader(zr, maxObjectSize))
+	if err != nil {
+		return "", nil, err
+	}
+
+	nul := bytes.IndexByte(raw, 0)
+	if nul < 0 {
+		return "", nil, errors.New("gittag: malformed object " + object)
+	}
+
+	header := strings.SplitN(string(raw[:nul]), " ", 2)
+
+	return header[0], raw[nul+1:], nil
+}
+
+// tagTarget returns the hash from the "object" header of a tag object.
+func tagTarget(body []byte) string {
+	for _, line := range strings.Split(string(body), "\n") {
+		if line == "" {
+			break
+		}
+
+		if strings.HasPrefix(line, "object ") {
+			return strings.TrimPrefix(line, "object ")
+		}
+	}
+
+	return ""
+}
+
+// resolveHead returns the commit hash HEAD points to, or an empty string if it
+// could not be resolved.
+func resolveHead(gitDir, commonDir string) string {
+	raw, err := ioutil.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return ""
+	}
+
+	head := strings.TrimSpace(string(raw))
+
+	for i := 0; i < maxPeelDepth && strings.HasPrefix(head, "ref: "); i++ {
+		head = resolveRef(gitDir, commonDir, strings.TrimPrefix(head, "ref: "))
+	}
+
+	return head
+}
+
+// resolveRef returns the content of the given ref, which may be a hash or
+// another symbolic ref.
+func resolveRef(gitDir, commonDir, name string) string {
+	for _, dir := range []string{gitDir, commonDir} {
+		if raw, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name))); err == nil {
+			return strings.TrimSpace(string(raw))
+		}
+	}
+
+	file, err := os.Open(filepath.Join(commonDir, "packed-refs"))
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), " ", 2)
+		if len(parts) == 2 && parts[1] == name {
+			return parts[0]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,80 @@
+package gittag
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+)
+
+// OpenExec reads the tags of the git repository at the given path by running
+// the local git executable.
+//
+// Unlike Open, OpenExec resolves every annotated tag to its commit regardless
+// of how the repository's objects are stored.
+func OpenExec(path string) (*Repository, error) {
+	raw, err := runGit(path, "for-each-ref", "--format=%(refname)%00%(objectname)%00%(*objectname)", tagsPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(Repository)
+
+	for _, line := range strings.Split(raw, "\n") {
+		parts := strings.Split(line, "\x00")
+		if len(parts) != 3 || !strings.HasPrefix(parts[0], tagsPrefix) {
+			continue
+		}
+
+		rf := ref{name: parts[0][len(tagsPrefix):], object: parts[1], commit: parts[2]}
+		if rf.commit == "" {
+			rf.commit = rf.object
+		}
+
+		out.refs = append(out.refs, rf)
+	}
+
+	// HEAD may not exist in a new repository with no commits.
+	if head, err := runGit(path, "rev-parse", "--verify", "--quiet", "HEAD"); err == nil {
+		out.head = strings.TrimSpace(head)
+	}
+
+	return out, nil
+}
+
+func runGit(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", &ExecError{Args: args, Msg: msg, Err: err}
+		}
+		return "", &ExecError{Args: args, Err: err}
+	}
+
+	return stdout.String(), nil
+}
+
+// ExecError is returned by OpenExec when running git fails.
+type ExecError struct {
+	Args []string
+	Msg  string
+	Err  error
+}
+
+func (e *ExecError) Error() string {
+	out := "gittag: git " + strings.Join(e.Args, " ") + ": " + e.Err.Error()
+
+	if e.Msg != "" {
+		out += ": " + e.Msg
+	}
+
+	return out
+}
+
+func (e *ExecError) Unwrap() error {
+	return e.Err
+}
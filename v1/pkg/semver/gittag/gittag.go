@@ -0,0 +1,157 @@
+// Package gittag reads semantic versions from the tags of a local git
+// repository.
+//
+// Repositories may be read directly from their .git directory with Open, or
+// by running the local git executable with OpenExec.  Neither method accesses
+// the network.
+package gittag
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// ErrNotFound is returned when no tag matches a query.
+var ErrNotFound = errors.New("gittag: no matching tag found")
+
+// Tag is a git tag whose name holds a semantic version.
+type Tag struct {
+	// Name is the name of the tag, without the "refs/tags/" prefix.
+	Name string
+
+	// Version is the version parsed from the tag name.
+	Version semver.Version
+
+	// Object is the hash of the object the tag ref points to.  For lightweight
+	// tags this is the commit hash, for annotated tags it is the hash of the
+	// tag object.
+	Object string
+
+	// Commit is the hash of the commit the tag points to.  This may be empty
+	// for an annotated tag if its tag object could not be read.
+	Commit string
+}
+
+// ref is a single tag ref as read from a repository.
+type ref struct {
+	name   string
+	object string
+	commit string
+}
+
+// Repository holds the tags read from a local git repository.
+//
+// A Repository is a snapshot of the tags at the time it was opened, and is
+// safe for concurrent use.
+type Repository struct {
+	refs []ref
+	head string
+}
+
+// Head returns the hash of the commit checked out in the repository when it
+// was opened.
+func (r *Repository) Head() string {
+	return r.head
+}
+
+// Tags returns every tag whose name is the given prefix followed by a valid
+// SemVer 2.0.0 version string, sorted from lowest to highest precedence.
+//
+// For example, with the prefix "v" the tag "v1.2.3" is returned with the
+// version 1.2.3, and with the prefix "component/v" the tag
+// "component/v1.2.3" is returned with the same version.  Tags not matching the
+// prefix are ignored.
+func (r *Repository) Tags(prefix string) []Tag {
+	var out []Tag
+
+	for _, rf := range r.refs {
+		if !strings.HasPrefix(rf.name, prefix) {
+			continue
+		}
+
+		v, err := semver.ParseStrict(rf.name[len(prefix):])
+		if err != nil {
+			continue
+		}
+
+		out = append(out, Tag{Name: rf.name, Version: v, Object: rf.object, Commit: rf.commit})
+	}
+
+	// Tags of equal precedence, differing only in build metadata, are ordered
+	// by name.
+	sort.Slice(out, func(i, j int) bool {
+		if c := out[i].Version.Compare(&out[j].Version); c != 0 {
+			return c < 0
+		}
+
+		return out[i].Name < out[j].Name
+	})
+
+	return out
+}
+
+// LatestRelease returns the tag with the highest precedence version that is
+// not a prerelease.
+func (r *Repository) LatestRelease(prefix string) (Tag, error) {
+	return r.latest(prefix, false)
+}
+
+// LatestPrerelease returns the tag with the highest precedence prerelease
+// version.
+func (r *Repository) LatestPrerelease(prefix string) (Tag, error) {
+	return r.latest(prefix, true)
+}
+
+func (r *Repository) latest(prefix string, pre bool) (Tag, error) {
+	tags := r.Tags(prefix)
+
+	for i := len(tags) - 1; i >= 0; i-- {
+		if (len(tags[i].Version.Prerelease) > 0) == pre {
+			return tags[i], nil
+		}
+	}
+
+	return Tag{}, ErrNotFound
+}
+
+// TagsForCommit returns the tags pointing to the given commit, sorted from
+// lowest to highest precedence.
+//
+// The commit may be given as a full or abbreviated hash, or as "HEAD" for the
+// commit checked out when the repository was opened.
+func (r *Repository) TagsForCommit(prefix, commit string) []Tag {
+	if commit == "HEAD" {
+		commit = r.head
+	}
+
+	commit = strings.ToLower(commit)
+
+	var out []Tag
+
+	if commit == "" {
+		return out
+	}
+
+	for _, t := range r.Tags(prefix) {
+		if strings.HasPrefix(t.Commit, commit) {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+// TagForCommit returns the highest precedence tag pointing to the given
+// commit.  See TagsForCommit.
+func (r *Repository) TagForCommit(prefix, commit string) (Tag, error) {
+	tags := r.TagsForCommit(prefix, commit)
+
+	if len(tags) == 0 {
+		return Tag{}, ErrNotFound
+	}
+
+	return tags[len(tags)-1], nil
+}
@@ -0,0 +1,222 @@
+package gittag_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver/gittag"
+)
+
+// testRepo creates a git repository with the following history:
+//
+//	c1  v1.0.0 (lightweight), component/v0.1.0 (annotated)
+//	c2  v1.1.0 (annotated), v1.2.0-rc.1 (lightweight)
+//	c3  v1.2.0-rc.2 (annotated), not-a-version, v1.2 (lightweight), HEAD
+func testRepo(t *testing.T) (dir string, commits []string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not available")
+	}
+
+	dir = t.TempDir()
+
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"-c", "commit.gpgsign=false", "-c", "tag.gpgsign=false",
+			"-c", "init.defaultBranch=main",
+		}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_CONFIG_NOSYSTEM=1", "HOME="+dir)
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %s: %s", strings.Join(args, " "), err, out)
+		}
+
+		return strings.TrimSpace(string(out))
+	}
+
+	commit := func() string {
+		git("commit", "--allow-empty", "-m", "commit")
+		return git("rev-parse", "HEAD")
+	}
+
+	git("init", "-q")
+
+	commits = append(commits, commit())
+	git("tag", "v1.0.0")
+	git("tag", "-a", "-m", "component", "component/v0.1.0")
+
+	commits = append(commits, commit())
+	git("tag", "-a", "-m", "release", "v1.1.0")
+	git("tag", "v1.2.0-rc.1")
+
+	commits = append(commits, commit())
+	git("tag", "-a", "-m", "rc", "v1.2.0-rc.2")
+	git("tag", "not-a-version")
+	git("tag", "v1.2")
+
+	return dir, commits
+}
+
+func TestOpen(t *testing.T) {
+	dir, commits := testRepo(t)
+
+	openers := map[string]func(string) (*gittag.Repository, error){
+		"Open":     gittag.Open,
+		"OpenExec": gittag.OpenExec,
+	}
+
+	check := func(t *testing.T, repo *gittag.Repository) {
+		if repo.Head() != commits[2] {
+			t.Errorf("Expected HEAD %s, got %s", commits[2], repo.Head())
+		}
+
+		var names []string
+		for _, tag := range repo.Tags("v") {
+			names = append(names, tag.Name)
+		}
+
+		expect := "v1.0.0 v1.1.0 v1.2.0-rc.1 v1.2.0-rc.2"
+		if strings.Join(names, " ") != expect {
+			t.Errorf("Expected tags %s, got %s", expect, strings.Join(names, " "))
+		}
+
+		release, err := repo.LatestRelease("v")
+		if err != nil || release.Name != "v1.1.0" || release.Commit != commits[1] {
+			t.Errorf("Expected latest release v1.1.0 at %s, got %+v, %v", commits[1], release, err)
+		}
+		if release.Object == release.Commit {
+			t.Error("Expected annotated tag object to differ from its commit")
+		}
+
+		pre, err := repo.LatestPrerelease("v")
+		if err != nil || pre.Version.String() != "1.2.0-rc.2" || pre.Commit != commits[2] {
+			t.Errorf("Expected latest prerelease 1.2.0-rc.2 at %s, got %+v, %v", commits[2], pre, err)
+		}
+
+		component, err := repo.LatestRelease("component/v")
+		if err != nil || component.Version.String() != "0.1.0" || component.Commit != commits[0] {
+			t.Errorf("Expected component 0.1.0 at %s, got %+v, %v", commits[0], component, err)
+		}
+
+		if _, err := repo.LatestPrerelease("component/v"); err != gittag.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+
+		tag, err := repo.TagForCommit("v", commits[1][:10])
+		if err != nil || tag.Name != "v1.2.0-rc.1" {
+			t.Errorf("Expected v1.2.0-rc.1 for %s, got %+v, %v", commits[1], tag, err)
+		}
+
+		if tags := repo.TagsForCommit("v", "HEAD"); len(tags) != 1 || tags[0].Name != "v1.2.0-rc.2" {
+			t.Errorf("Expected v1.2.0-rc.2 at HEAD, got %+v", tags)
+		}
+	}
+
+	for name, open := range openers {
+		t.Run(name, func(t *testing.T) {
+			repo, err := open(dir)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			check(t, repo)
+		})
+	}
+
+	// Move every ref into packed-refs, leaving peeled entries for annotated
+	// tags, and pack all objects so tag objects are no longer loose.
+	cmd := exec.Command("git", "gc", "-q")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git gc: %s: %s", err, out)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, ".git", "packed-refs")); err != nil {
+		t.Fatal("expected git gc to create packed-refs")
+	}
+
+	for name, open := range openers {
+		t.Run(name+" packed", func(t *testing.T) {
+			repo, err := open(dir)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			check(t, repo)
+		})
+	}
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "tag.gpgsign=false",
+		}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_CONFIG_NOSYSTEM=1", "HOME="+dir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %s: %s", strings.Join(args, " "), err, out)
+		}
+	}
+
+	checkHead := func(t *testing.T, repo *gittag.Repository, expect string) {
+		var names []string
+		for _, tag := range repo.TagsForCommit("v", "HEAD") {
+			names = append(names, tag.Name)
+			if tag.Commit != commits[2] {
+				t.Errorf("Expected %s at %s, got %s", tag.Name, commits[2], tag.Commit)
+			}
+		}
+
+		if strings.Join(names, " ") != expect {
+			t.Errorf("Expected tags %s at HEAD, got %s", expect, strings.Join(names, " "))
+		}
+	}
+
+	// Tags created after packing are loose refs pointing to packed commits.
+	git("tag", "v1.3.0")
+	git("tag", "-a", "-m", "release", "v1.4.0")
+
+	for name, open := range openers {
+		t.Run(name+" tagged after packing", func(t *testing.T) {
+			repo, err := open(dir)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			checkHead(t, repo, "v1.2.0-rc.2 v1.3.0 v1.4.0")
+		})
+	}
+
+	// Repacking leaves the tag refs loose but moves their tag objects into a
+	// pack.  Tags with long, similar messages are stored as deltas.
+	notes := strings.Repeat("Release notes line.\n", 200)
+	git("tag", "-a", "-m", notes+"one", "v1.5.0")
+	git("tag", "-a", "-m", notes+"two", "v1.6.0")
+	git("repack", "-a", "-d", "-q")
+	git("prune-packed")
+
+	for name, open := range openers {
+		t.Run(name+" tag objects packed", func(t *testing.T) {
+			repo, err := open(dir)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			checkHead(t, repo, "v1.2.0-rc.2 v1.3.0 v1.4.0 v1.5.0 v1.6.0")
+		})
+	}
+}
+
+func TestOpen_notRepository(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := gittag.Open(dir); err == nil {
+		t.Error("Expected an error opening a directory that is not a repository")
+	}
+
+	if _, err := exec.LookPath("git"); err == nil {
+		if _, err := gittag.OpenExec(dir); err == nil {
+			t.Error("Expected an error opening a directory that is not a repository")
+		}
+	}
+}
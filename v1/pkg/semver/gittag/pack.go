@@ -0,0 +1,388 @@
+package gittag
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxObjectSize limits the size of the objects that will be read.
+	maxObjectSize = 1 << 20
+
+	// maxDeltaDepth limits the length of the delta chains that will be followed
+	// when reading packed objects.
+	maxDeltaDepth = 64
+)
+
+var errMalformedPack = errors.New("gittag: malformed pack file")
+
+// packTypes maps the object type numbers used in pack files to object types.
+var packTypes = [...]string{1: "commit", 2: "tree", 3: "blob", 4: "tag"}
+
+const (
+	packOfsDelta = 6
+	packRefDelta = 7
+)
+
+// objectStore reads objects from the loose objects and pack files of a git
+// directory.  Objects in alternate object directories are not read.
+type objectStore struct {
+	gitDir string
+
+	// packs holds the indexes of the pack files, read on first use.
+	packs  []*packIndex
+	loaded bool
+}
+
+func newObjectStore(gitDir string) *objectStore {
+	return &objectStore{gitDir: gitDir}
+}
+
+// read returns the type and content of the object with the given hash.
+func (s *objectStore) read(object string) (kind string, body []byte, err error) {
+	return s.readDepth(object, 0)
+}
+
+func (s *objectStore) readDepth(object string, depth int) (kind string, body []byte, err error) {
+	if kind, body, err := readLooseObject(s.gitDir, object); err == nil {
+		return kind, body, nil
+	}
+
+	hash, err := hex.DecodeString(object)
+	if err != nil || len(hash) == 0 {
+		return "", nil, errors.New("gittag: invalid object hash " + object)
+	}
+
+	if !s.loaded {
+		s.loadPacks(len(hash))
+	}
+
+	for _, idx := range s.packs {
+		if offset, ok := idx.find(hash); ok {
+			return s.readPacked(idx, offset, depth)
+		}
+	}
+
+	return "", nil, errors.New("gittag: object not found " + object)
+}
+
+// loadPacks reads the index of every pack file, skipping any that cannot be
+// read or that use a different hash size.
+func (s *objectStore) loadPacks(hashSize int) {
+	s.loaded = true
+
+	paths, _ := filepath.Glob(filepath.Join(s.gitDir, "objects", "pack", "*.idx"))
+
+	for _, path := range paths {
+		if idx, err := readPackIndex(path, hashSize); err == nil {
+			s.packs = append(s.packs, idx)
+		}
+	}
+}
+
+// readPacked reads the object stored at the given offset of the pack file
+// belonging to the given index, resolving deltas against their base objects.
+func (s *objectStore) readPacked(idx *packIndex, offset int64, depth int) (kind string, body []byte, err error) {
+	if depth > maxDeltaDepth {
+		return "", nil, errors.New("gittag: delta chain too long in " + idx.pack)
+	}
+
+	file, err := os.Open(idx.pack)
+	if err != nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(io.NewSectionReader(file, offset, 1<<62))
+
+	// The header holds the object type and the size of its inflated data in a
+	// variable length encoding, least significant bits first.
+	b, err := r.ReadByte()
+	if err != nil {
+		return "", nil, err
+	}
+
+	typ := int(b>>4) & 7
+	size := uint64(b & 0x0f)
+
+	for shift := uint(4); b&0x80 != 0; shift += 7 {
+		if b, err = r.ReadByte(); err != nil {
+			return "", nil, err
+		} else if shift > 56 {
+			return "", nil, errMalformedPack
+		}
+		size |= uint64(b&0x7f) << shift
+	}
+
+	if size > maxObjectSize {
+		return "", nil, errors.New("gittag: object too large in " + idx.pack)
+	}
+
+	switch typ {
+	case packOfsDelta:
+		// The base is stored at a preceding offset in the same pack, encoded
+		// most significant bits first with an implicit increment per byte.
+		if b, err = r.ReadByte(); err != nil {
+			return "", nil, err
+		}
+
+		rel := int64(b & 0x7f)
+		for b&0x80 != 0 {
+			if b, err = r.ReadByte(); err != nil {
+				return "", nil, err
+			} else if rel >= offset {
+				return "", nil, errMalformedPack
+			}
+			rel = (rel+1)<<7 | int64(b&0x7f)
+		}
+
+		if rel <= 0 || rel > offset {
+			return "", nil, errMalformedPack
+		}
+
+		delta, err := inflate(r, size)
+		if err != nil {
+			return "", nil, err
+		}
+
+		kind, base, err := s.readPacked(idx, offset-rel, depth+1)
+		if err != nil {
+			return "", nil, err
+		}
+
+		body, err = applyDelta(base, delta)
+		return kind, body, err
+
+	case packRefDelta:
+		hash := make([]byte, idx.hashSize)
+		if _, err := io.ReadFull(r, hash); err != nil {
+			return "", nil, err
+		}
+
+		delta, err := inflate(r, size)
+		if err != nil {
+			return "", nil, err
+		}
+
+		kind, base, err := s.readDepth(hex.EncodeToString(hash), depth+1)
+		if err != nil {
+			return "", nil, err
+		}
+
+		body, err = applyDelta(base, delta)
+		return kind, body, err
+	}
+
+	if typ >= len(packTypes) || packTypes[typ] == "" {
+		return "", nil, errMalformedPack
+	}
+
+	body, err = inflate(r, size)
+	return packTypes[typ], body, err
+}
+
+func inflate(r io.Reader, size uint64) ([]byte, error) {
+	zr, err := zlib.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	out := make([]byte, size)
+	if _, err := io.ReadFull(zr, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// applyDelta rebuilds an object from its base and a delta made up of
+// instructions to copy ranges of the base or to insert literal data.
+func applyDelta(base, delta []byte) ([]byte, error) {
+	pos := 0
+
+	readSize := func() (uint64, bool) {
+		var out uint64
+
+		for shift := uint(0); pos < len(delta) && shift < 64; shift += 7 {
+			b := delta[pos]
+			pos++
+			out |= uint64(b&0x7f) << shift
+
+			if b&0x80 == 0 {
+				return out, true
+			}
+		}
+
+		return 0, false
+	}
+
+	if src, ok := readSize(); !ok || src != uint64(len(base)) {
+		return nil, errMalformedPack
+	}
+
+	dst, ok := readSize()
+	if !ok || dst > maxObjectSize {
+		return nil, errMalformedPack
+	}
+
+	out := make([]byte, 0, dst)
+
+	for pos < len(delta) {
+		cmd := delta[pos]
+		pos++
+
+		switch {
+		case cmd&0x80 != 0:
+			var offset, size uint64
+
+			for i := uint(0); i < 7; i++ {
+				if cmd&(1<<i) == 0 {
+					continue
+				}
+
+				if pos >= len(delta) {
+					return nil, errMalformedPack
+				}
+
+				if i < 4 {
+					offset |= uint64(delta[pos]) << (8 * i)
+				} else {
+					size |= uint64(delta[pos]) << (8 * (i - 4))
+				}
+				pos++
+			}
+
+			if size == 0 {
+				size = 0x10000
+			}
+
+			if offset+size > uint64(len(base)) || uint64(len(out))+size > dst {
+				return nil, errMalformedPack
+			}
+
+			out = append(out, base[offset:offset+size]...)
+
+		case cmd != 0:
+			n := int(cmd)
+			if pos+n > len(delta) || uint64(len(out)+n) > dst {
+				return nil, errMalformedPack
+			}
+
+			out = append(out, delta[pos:pos+n]...)
+			pos += n
+
+		default:
+			return nil, errMalformedPack
+		}
+	}
+
+	if uint64(len(out)) != dst {
+		return nil, errMalformedPack
+	}
+
+	return out, nil
+}
+
+// packIndex is a version 2 pack index, mapping the hashes of the objects in a
+// pack file to their offsets in it.
+type packIndex struct {
+	// pack is the path of the pack file.
+	pack     string
+	hashSize int
+
+	fanout  []byte
+	hashes  []byte
+	offsets []byte
+
+	// large holds 8 byte offsets for packs of 2GiB or more.
+	large []byte
+}
+
+var packIndexMagic = []byte{0xff, 't', 'O', 'c'}
+
+func readPackIndex(path string, hashSize int) (*packIndex, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	const fanoutStart, fanoutSize = 8, 256 * 4
+
+	if len(raw) < fanoutStart+fanoutSize || !bytes.Equal(raw[:4], packIndexMagic) ||
+		binary.BigEndian.Uint32(raw[4:8]) != 2 {
+		return nil, errors.New("gittag: unsupported pack index " + path)
+	}
+
+	fanout := raw[fanoutStart : fanoutStart+fanoutSize]
+	count := int(binary.BigEndian.Uint32(fanout[fanoutSize-4:]))
+
+	hashStart := fanoutStart + fanoutSize
+	offsetStart := hashStart + count*(hashSize+4)
+	largeStart := offsetStart + count*4
+
+	// The index ends with the checksums of the pack and of the index.
+	if count < 0 || largeStart+2*hashSize > len(raw) {
+		return nil, errors.New("gittag: malformed pack index " + path)
+	}
+
+	return &packIndex{
+		pack:     strings.TrimSuffix(path, ".idx") + ".pack",
+		hashSize: hashSize,
+		fanout:   fanout,
+		hashes:   raw[hashStart : hashStart+count*hashSize],
+		offsets:  raw[offsetStart:largeStart],
+		large:    raw[largeStart : len(raw)-2*hashSize],
+	}, nil
+}
+
+// find returns the offset in the pack file of the object with the given hash.
+func (p *packIndex) find(hash []byte) (int64, bool) {
+	if len(hash) != p.hashSize {
+		return 0, false
+	}
+
+	lo := 0
+	if hash[0] > 0 {
+		lo = int(binary.BigEndian.Uint32(p.fanout[(int(hash[0])-1)*4:]))
+	}
+	hi := int(binary.BigEndian.Uint32(p.fanout[int(hash[0])*4:]))
+
+	if hi > len(p.hashes)/p.hashSize || lo > hi {
+		return 0, false
+	}
+
+	i := lo + sort.Search(hi-lo, func(i int) bool {
+		return bytes.Compare(p.hash(lo+i), hash) >= 0
+	})
+
+	if i >= hi || !bytes.Equal(p.hash(i), hash) {
+		return 0, false
+	}
+
+	offset := binary.BigEndian.Uint32(p.offsets[i*4:])
+	if offset&0x80000000 == 0 {
+		return int64(offset), true
+	}
+
+	j := int(offset&0x7fffffff) * 8
+	if j+8 > len(p.large) {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(p.large[j:])), true
+}
+
+func (p *packIndex) hash(i int) []byte {
+	return p.hashes[i*p.hashSize : (i+1)*p.hashSize]
+}
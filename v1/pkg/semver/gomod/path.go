@@ -0,0 +1,207 @@
+package gomod
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// incompatible is the build metadata marking a v2 or later version of a
+// module whose path has no major version suffix.
+const incompatible = "incompatible"
+
+// PathError is returned by CheckPath when a version is not valid for a module
+// path.
+type PathError struct {
+	Path    string
+	Version semver.Version
+
+	// Msg describes the problem.
+	Msg string
+}
+
+func (p *PathError) Error() string {
+	return "gomod: version " + p.Version.VString() + " invalid for module " + strconv.Quote(p.Path) + ": " + p.Msg
+}
+
+// IsIncompatible returns whether the given version carries the "+incompatible"
+// build metadata.
+func IsIncompatible(v *semver.Version) bool {
+	return len(v.Build) == 1 && v.Build[0] == incompatible
+}
+
+// SplitPathVersion splits a module path into its prefix and its major version
+// suffix, such as "/v2", or ".v2" for gopkg.in paths.  The suffix is empty if
+// the path has none.  Returns false if the path ends in a malformed suffix,
+// such as "/v1", "/v02", or "/v2.1".
+//
+// The last element of a path is taken to be a major version suffix if it is a
+// 'v' followed only by digits and dots.  Outside gopkg.in, suffixes for major
+// versions 0 and 1 are not permitted, as those versions use the bare path.
+func SplitPathVersion(path string) (prefix, pathMajor string, ok bool) {
+	if strings.HasPrefix(path, "gopkg.in/") {
+		return splitGopkgIn(path)
+	}
+
+	slash := strings.LastIndexByte(path, '/')
+	if slash < 0 {
+		return path, "", true
+	}
+
+	elem := path[slash+1:]
+	if len(elem) < 2 || elem[0] != 'v' || strings.Trim(elem[1:], "0123456789.") != "" {
+		return path, "", true
+	}
+
+	if !isMajor(elem[1:]) || elem == "v0" || elem == "v1" {
+		return path, "", false
+	}
+
+	return path[:slash], path[slash:], true
+}
+
+// splitGopkgIn splits a gopkg.in path, which must end in a ".vN" suffix,
+// optionally followed by "-unstable".  Unlike other paths, ".v0" and ".v1" are
+// permitted.
+func splitGopkgIn(path string) (prefix, pathMajor string, ok bool) {
+	dot := strings.LastIndex(path, ".v")
+	if dot < 0 || !isMajor(strings.TrimSuffix(path[dot+2:], "-unstable")) {
+		return path, "", false
+	}
+
+	return path[:dot], path[dot:], true
+}
+
+// isMajor returns whether the given string is a major version number without
+// leading zeros.
+func isMajor(s string) bool {
+	if s == "" || s[0] == '0' && s != "0" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MajorSuffix returns the major version suffix a module path must end with to
+// hold the given version: "/vN" for major versions 2 and later, and nothing
+// for major versions 0 and 1 or "+incompatible" versions.  For gopkg.in paths
+// the suffix is always ".vN".
+func MajorSuffix(path string, v semver.Version) string {
+	major := strconv.FormatUint(v.Major, 10)
+
+	switch {
+	case strings.HasPrefix(path, "gopkg.in/"):
+		return ".v" + major
+	case v.Major < 2 || IsIncompatible(&v):
+		return ""
+	}
+
+	return "/v" + major
+}
+
+// CheckPath returns a *PathError if the given version may not be used as a
+// version of the module with the given path.
+//
+// A module path without a major version suffix only holds major versions 0
+// and 1, and "+incompatible" versions of major version 2 or later.  A path
+// with a "/vN" suffix only holds versions of major version N, and a gopkg.in
+// path with a ".vN" suffix only holds versions of major version N, as well as
+// v0.0.0 pseudo-versions for ".v1".  Build metadata other than "+incompatible"
+// is not permitted.
+func CheckPath(path string, v semver.Version) error {
+	fail := func(msg string) error {
+		return &PathError{Path: path, Version: v, Msg: msg}
+	}
+
+	_, pathMajor, ok := SplitPathVersion(path)
+	if !ok {
+		return fail("malformed major version suffix")
+	}
+
+	inc := IsIncompatible(&v)
+	major := "v" + strconv.FormatUint(v.Major, 10)
+
+	if len(v.Build) > 0 && !inc {
+		return fail("build metadata other than +incompatible is not permitted")
+	}
+
+	switch {
+	case pathMajor == "":
+		if inc && v.Major < 2 {
+			return fail("+incompatible requires major version v2 or later, not " + major)
+		}
+		if !inc && v.Major >= 2 {
+			return fail("should be v0 or v1, not " + major + " (module path requires /" + major + " suffix)")
+		}
+
+	case inc:
+		return fail("+incompatible is not permitted with major version suffix " + pathMajor)
+
+	case pathMajor[0] == '.':
+		want := strings.TrimSuffix(pathMajor[1:], "-unstable")
+		if want == major {
+			return nil
+		}
+		if want == "v1" && v.Major == 0 && v.Minor == 0 && v.Patch == 0 && IsPseudo(&v) {
+			return nil
+		}
+		return fail("should be " + want + ", not " + major)
+
+	case pathMajor[1:] != major:
+		return fail("should be " + pathMajor[1:] + ", not " + major)
+	}
+
+	return nil
+}
+
+// Canonical parses the given version string as Go does, and returns the
+// version in the canonical form required in go.mod files.
+//
+// The string must begin with 'v'.  The shorthands "vX" and "vX.Y" are
+// accepted, without prerelease or build metadata, and are filled in with zero
+// minor and patch versions.  As in go.mod files, build metadata is discarded
+// unless it is "+incompatible".
+func Canonical(s string) (semver.Version, error) {
+	if len(s) == 0 || s[0] != 'v' {
+		return semver.Version{}, errors.New("gomod: invalid version " + strconv.Quote(s) + ": missing leading v")
+	}
+
+	body := s[1:]
+
+	end := strings.IndexAny(body, "-+")
+	if end < 0 {
+		end = len(body)
+	}
+
+	if dots := strings.Count(body[:end], "."); dots < 2 {
+		if end != len(body) {
+			return semver.Version{}, errors.New("gomod: invalid version " + strconv.Quote(s) +
+				": shorthand versions may not have prerelease or build metadata")
+		}
+
+		body += strings.Repeat(".0", 2-dots)
+	}
+
+	v, err := semver.ParseStrict(body)
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	if !IsIncompatible(&v) {
+		v.Build = nil
+	}
+
+	return v, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
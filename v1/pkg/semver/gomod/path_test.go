@@ -0,0 +1,194 @@
+package gomod_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/gomod"
+)
+
+func mustGoVersion(t *testing.T, s string) semver.Version {
+	v, err := semver.ParseStrict(s[1:])
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	return v
+}
+
+func TestSplitPathVersion(t *testing.T) {
+	tests := map[string]struct {
+		prefix, major string
+		ok            bool
+	}{
+		"example.com/mod":            {"example.com/mod", "", true},
+		"example.com/mod/v2":         {"example.com/mod", "/v2", true},
+		"example.com/mod/v10":        {"example.com/mod", "/v10", true},
+		"example.com/mod/v1":         {"example.com/mod/v1", "", false},
+		"example.com/mod/v0":         {"example.com/mod/v0", "", false},
+		"example.com/mod/v02":        {"example.com/mod/v02", "", false},
+		"example.com/mod/v2.1":       {"example.com/mod/v2.1", "", false},
+		"example.com/modv2":          {"example.com/modv2", "", true},
+		"example.com/v2mod":          {"example.com/v2mod", "", true},
+		"gopkg.in/yaml.v2":           {"gopkg.in/yaml", ".v2", true},
+		"gopkg.in/yaml.v0":           {"gopkg.in/yaml", ".v0", true},
+		"gopkg.in/check.v1-unstable": {"gopkg.in/check", ".v1-unstable", true},
+		"gopkg.in/yaml":              {"gopkg.in/yaml", "", false},
+		"gopkg.in/yaml.v01":          {"gopkg.in/yaml.v01", "", false},
+		"gopkg.in/yaml.v-unstable":   {"gopkg.in/yaml.v-unstable", "", false},
+		"gopkg.in/yaml.vendor":       {"gopkg.in/yaml.vendor", "", false},
+		"example.com/mod/v":          {"example.com/mod/v", "", true},
+		"example.com/mod/v2/":        {"example.com/mod/v2/", "", true},
+		"v2":                         {"v2", "", true},
+	}
+
+	for path, test := range tests {
+		t.Run(path, func(t *testing.T) {
+			prefix, major, ok := gomod.SplitPathVersion(path)
+
+			if prefix != test.prefix || major != test.major || ok != test.ok {
+				t.Errorf("Expected (%s, %s, %t), got (%s, %s, %t)", test.prefix, test.major, test.ok, prefix, major, ok)
+			}
+		})
+	}
+}
+
+func TestMajorSuffix(t *testing.T) {
+	tests := []struct {
+		path, version, expect string
+	}{
+		{"example.com/mod", "v0.3.0", ""},
+		{"example.com/mod", "v1.9.0", ""},
+		{"example.com/mod", "v2.0.0", "/v2"},
+		{"example.com/mod/v3", "v3.1.0", "/v3"},
+		{"example.com/mod", "v2.0.0+incompatible", ""},
+		{"gopkg.in/yaml.v2", "v2.4.0", ".v2"},
+		{"gopkg.in/yaml.v1", "v1.0.0", ".v1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path+"@"+test.version, func(t *testing.T) {
+			if got := gomod.MajorSuffix(test.path, mustGoVersion(t, test.version)); got != test.expect {
+				t.Errorf("Expected %q, got %q", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestCheckPath(t *testing.T) {
+	tests := []struct {
+		path, version string
+		ok            bool
+	}{
+		{"example.com/mod", "v0.1.0", true},
+		{"example.com/mod", "v1.2.3", true},
+		{"example.com/mod", "v2.0.0", false},
+		{"example.com/mod", "v2.0.0+incompatible", true},
+		{"example.com/mod", "v1.0.0+incompatible", false},
+		{"example.com/mod", "v1.0.0+build.1", false},
+		{"example.com/mod/v2", "v2.3.0", true},
+		{"example.com/mod/v2", "v3.0.0", false},
+		{"example.com/mod/v2", "v1.0.0", false},
+		{"example.com/mod/v2", "v2.0.0+incompatible", false},
+		{"example.com/mod/v2", "v2.0.1-0.20191109021931-daa7c04131f5", true},
+		{"example.com/mod/v1", "v1.0.0", false},
+		{"gopkg.in/yaml.v2", "v2.4.0", true},
+		{"gopkg.in/yaml.v2", "v3.0.0", false},
+		{"gopkg.in/check.v1-unstable", "v1.0.0", true},
+		{"gopkg.in/yaml.v1", "v0.0.0-20191109021931-daa7c04131f5", true},
+		{"gopkg.in/yaml.v1", "v0.1.0", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path+"@"+test.version, func(t *testing.T) {
+			err := gomod.CheckPath(test.path, mustGoVersion(t, test.version))
+
+			if test.ok && err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+
+			var perr *gomod.PathError
+			if !test.ok && !errors.As(err, &perr) {
+				t.Errorf("Expected *PathError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckPath_Message(t *testing.T) {
+	err := gomod.CheckPath("example.com/mod", semver.Version{Major: 2})
+
+	expect := `gomod: version v2.0.0 invalid for module "example.com/mod": should be v0 or v1, not v2 ` +
+		`(module path requires /v2 suffix)`
+
+	if err == nil || err.Error() != expect {
+		t.Errorf("Expected %s, got %v", expect, err)
+	}
+}
+
+func TestIsIncompatible(t *testing.T) {
+	tests := map[string]bool{
+		"v2.0.0+incompatible":       true,
+		"v2.0.0":                    false,
+		"v2.0.0+build":              false,
+		"v2.0.0+incompatible.build": false,
+	}
+
+	for input, expect := range tests {
+		t.Run(input, func(t *testing.T) {
+			v := mustGoVersion(t, input)
+
+			if got := gomod.IsIncompatible(&v); got != expect {
+				t.Errorf("Expected %t, got %t", expect, got)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := map[string]string{
+		"v1":                                 "v1.0.0",
+		"v1.2":                               "v1.2.0",
+		"v1.2.3":                             "v1.2.3",
+		"v1.2.3-rc.1":                        "v1.2.3-rc.1",
+		"v1.2.3+build.5":                     "v1.2.3",
+		"v1.2.3-rc.1+build":                  "v1.2.3-rc.1",
+		"v2.0.0+incompatible":                "v2.0.0+incompatible",
+		"v0.0.0-20191109021931-daa7c04131f5": "v0.0.0-20191109021931-daa7c04131f5",
+	}
+
+	for input, expect := range tests {
+		t.Run(input, func(t *testing.T) {
+			v, err := gomod.Canonical(input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if got := v.VString(); got != expect {
+				t.Errorf("Expected %s, got %s", expect, got)
+			}
+		})
+	}
+}
+
+func TestCanonical_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"1.2.3",
+		"v",
+		"v1.2-rc.1",
+		"v1+build",
+		"v01.2.3",
+		"v1.2.3.4",
+		"v1.2.3-",
+		"V1.2.3",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if _, err := gomod.Canonical(input); err == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
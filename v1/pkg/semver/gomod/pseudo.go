@@ -0,0 +1,223 @@
+// Package gomod relates semantic versions to the conventions of Go modules.
+//
+// Go identifies untagged commits with pseudo-versions, which are ordinary
+// semantic versions whose prerelease identifiers encode the commit time and
+// revision.  A pseudo-version takes one of three forms, depending on the most
+// recent tag preceding the commit:
+//
+//	vX.0.0-yyyymmddhhmmss-abcdefabcdef       no preceding tag
+//	vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef preceded by vX.Y.Z-pre
+//	vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdefabcdef preceded by vX.Y.Z
+//
+// Pseudo-versions order correctly against tagged versions by plain semantic
+// version precedence, so they may be compared with semver.Version.Compare.
+//
+// Module paths must also agree with the major version of the versions they
+// hold: v2 and later versions live at paths ending in a "/vN" suffix, unless
+// marked "+incompatible".  CheckPath validates a version against a path, and
+// MajorSuffix gives the suffix a path requires.
+package gomod
+
+import (
+	"errors"
+	"time"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// PseudoTimeFormat is the layout of the timestamp in a pseudo-version, which
+// is always in UTC.
+const PseudoTimeFormat = "20060102150405"
+
+// ErrNotPseudo is returned when a version is not a pseudo-version.
+var ErrNotPseudo = errors.New("gomod: not a pseudo-version")
+
+// Pseudo is a pseudo-version decomposed into its parts.
+type Pseudo struct {
+	// Base is the tagged version preceding the revision.  If Tagged is false,
+	// no tag precedes the revision and only the major version of Base is set.
+	Base semver.Version
+
+	// Tagged is true if the pseudo-version follows a tagged version.
+	Tagged bool
+
+	// Time is the commit time of the revision, in UTC.
+	Time time.Time
+
+	// Revision is the abbreviated commit hash, usually its first 12 characters.
+	Revision string
+
+	// Build holds the build metadata identifiers of the pseudo-version, such as
+	// "incompatible".
+	Build []string
+}
+
+// IsPseudo returns whether the given version has the form of a pseudo-version.
+func IsPseudo(v *semver.Version) bool {
+	_, err := Decompose(*v)
+	return err == nil
+}
+
+// ParsePseudo parses and decomposes the given pseudo-version string, which
+// must have a leading 'v' as in Go.
+func ParsePseudo(s string) (Pseudo, error) {
+	if len(s) == 0 || s[0] != 'v' {
+		return Pseudo{}, ErrNotPseudo
+	}
+
+	v, err := semver.ParseStrict(s[1:])
+	if err != nil {
+		return Pseudo{}, err
+	}
+
+	return Decompose(v)
+}
+
+// Decompose splits the given pseudo-version into its base version, time, and
+// revision.  Returns ErrNotPseudo if the version is not a pseudo-version.
+func Decompose(v semver.Version) (Pseudo, error) {
+	pre := v.Prerelease
+	if len(pre) == 0 {
+		return Pseudo{}, ErrNotPseudo
+	}
+
+	stamp := pre[len(pre)-1]
+	if len(stamp) < len(PseudoTimeFormat)+2 || stamp[len(PseudoTimeFormat)] != '-' {
+		return Pseudo{}, ErrNotPseudo
+	}
+
+	out := Pseudo{Revision: stamp[len(PseudoTimeFormat)+1:], Build: v.Build}
+
+	for i := 0; i < len(out.Revision); i++ {
+		if !isAlphanumeric(out.Revision[i]) {
+			return Pseudo{}, ErrNotPseudo
+		}
+	}
+
+	t, err := time.Parse(PseudoTimeFormat, stamp[:len(PseudoTimeFormat)])
+	if err != nil {
+		return Pseudo{}, ErrNotPseudo
+	}
+	out.Time = t
+
+	switch {
+	case len(pre) == 1:
+		if v.Minor != 0 || v.Patch != 0 {
+			return Pseudo{}, ErrNotPseudo
+		}
+		out.Base.Major = v.Major
+
+	case pre[len(pre)-2] != "0":
+		return Pseudo{}, ErrNotPseudo
+
+	case len(pre) == 2:
+		// The release preceding vX.Y.0 has no patch number to decrement, so no
+		// valid pseudo-version has this form.
+		if v.Patch == 0 {
+			return Pseudo{}, ErrNotPseudo
+		}
+		out.Base = semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}
+		out.Tagged = true
+
+	default:
+		out.Base = semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Prerelease: pre[: len(pre)-2 : len(pre)-2]}
+		out.Tagged = true
+	}
+
+	return out, nil
+}
+
+// NewPseudo returns the pseudo-version of the given revision committed at the
+// given time, following the given tagged version.  The build metadata of the
+// tag, such as "+incompatible", is kept.
+//
+// A full 40 character hexadecimal commit hash is abbreviated to its first 12
+// characters.
+func NewPseudo(base semver.Version, t time.Time, revision string) (semver.Version, error) {
+	stamp, err := pseudoStamp(t, revision)
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	out := semver.Version{Major: base.Major, Minor: base.Minor, Patch: base.Patch, Build: base.Build}
+
+	if len(base.Prerelease) > 0 {
+		out.Prerelease = make([]string, 0, len(base.Prerelease)+2)
+		out.Prerelease = append(append(out.Prerelease, base.Prerelease...), "0", stamp)
+		return out, nil
+	}
+
+	if out.Patch == ^uint64(0) {
+		return semver.Version{}, semver.ErrOverflow
+	}
+
+	out.Patch++
+	out.Prerelease = []string{"0", stamp}
+
+	return out, nil
+}
+
+// NewUntaggedPseudo returns the pseudo-version of the given revision committed
+// at the given time, when no tag precedes the revision in the given major
+// version.
+//
+// A full 40 character hexadecimal commit hash is abbreviated to its first 12
+// characters.
+func NewUntaggedPseudo(major uint64, t time.Time, revision string) (semver.Version, error) {
+	stamp, err := pseudoStamp(t, revision)
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	return semver.Version{Major: major, Prerelease: []string{stamp}}, nil
+}
+
+// Version reassembles the pseudo-version.
+func (p *Pseudo) Version() (semver.Version, error) {
+	var out semver.Version
+	var err error
+
+	if p.Tagged {
+		out, err = NewPseudo(p.Base, p.Time, p.Revision)
+	} else {
+		out, err = NewUntaggedPseudo(p.Base.Major, p.Time, p.Revision)
+	}
+
+	if err == nil && len(p.Build) > 0 {
+		out.Build = p.Build
+	}
+
+	return out, err
+}
+
+func pseudoStamp(t time.Time, revision string) (string, error) {
+	if len(revision) == 40 && isHex(revision) {
+		revision = revision[:12]
+	}
+
+	if revision == "" {
+		return "", errors.New("gomod: empty pseudo-version revision")
+	}
+
+	for i := 0; i < len(revision); i++ {
+		if !isAlphanumeric(revision[i]) {
+			return "", errors.New("gomod: invalid pseudo-version revision " + revision)
+		}
+	}
+
+	return t.UTC().Format(PseudoTimeFormat) + "-" + revision, nil
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAlphanumeric(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,174 @@
+package gomod_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/gomod"
+)
+
+var pseudoTime = time.Date(2019, 11, 9, 2, 19, 31, 0, time.UTC)
+
+func TestParsePseudo(t *testing.T) {
+	tests := map[string]struct {
+		base   string
+		tagged bool
+		build  int
+	}{
+		"v0.0.0-20191109021931-daa7c04131f5":                   {"0.0.0", false, 0},
+		"v2.0.0-20191109021931-daa7c04131f5":                   {"2.0.0", false, 0},
+		"v1.2.4-0.20191109021931-daa7c04131f5":                 {"1.2.3", true, 0},
+		"v1.2.3-rc.1.0.20191109021931-daa7c04131f5":            {"1.2.3-rc.1", true, 0},
+		"v1.2.3-pre.0.20191109021931-daa7c04131f5":             {"1.2.3-pre", true, 0},
+		"v2.0.1-0.20191109021931-daa7c04131f5+incompatible":    {"2.0.0", true, 1},
+		"v3.0.0-20191109021931-daa7c04131f5+incompatible":      {"3.0.0", false, 1},
+		"v1.0.0-alpha.0.0.20191109021931-daa7c04131f5":         {"1.0.0-alpha.0", true, 0},
+		"v0.0.0-20191109021931-DAA7C04131F5":                   {"0.0.0", false, 0},
+		"v1.2.3-0.20191109021931-daa7c04131f5daa7c04131f5aaaa": {"1.2.2", true, 0},
+	}
+
+	for input, test := range tests {
+		t.Run(input, func(t *testing.T) {
+			p, err := gomod.ParsePseudo(input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if p.Base.String() != test.base {
+				t.Errorf("Expected base %s, got %s", test.base, p.Base.String())
+			}
+
+			if p.Tagged != test.tagged {
+				t.Errorf("Expected tagged %t, got %t", test.tagged, p.Tagged)
+			}
+
+			if !p.Time.Equal(pseudoTime) {
+				t.Errorf("Expected time %s, got %s", pseudoTime, p.Time)
+			}
+
+			if len(p.Build) != test.build {
+				t.Errorf("Expected %d build identifiers, got %d", test.build, len(p.Build))
+			}
+
+			v, err := p.Version()
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if got := v.VString(); got != input {
+				t.Errorf("Expected %s, got %s", input, got)
+			}
+		})
+	}
+}
+
+func TestParsePseudo_Invalid(t *testing.T) {
+	tests := []string{
+		"v1.2.3",
+		"1.2.4-0.20191109021931-daa7c04131f5",
+		"v1.2.0-20191109021931-daa7c04131f5",
+		"v1.2.0-0.20191109021931-daa7c04131f5",
+		"v1.2.4-1.20191109021931-daa7c04131f5",
+		"v1.2.4-0.2019110902193-daa7c04131f5",
+		"v1.2.4-0.20191309021931-daa7c04131f5",
+		"v1.2.4-0.20191109021931-",
+		"v1.2.4-0.20191109021931-daa7-c04131f5",
+		"v1.2.4-rc.1",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if _, err := gomod.ParsePseudo(input); err == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestIsPseudo(t *testing.T) {
+	v, _ := semver.Parse("v0.0.0-20191109021931-daa7c04131f5")
+	if !gomod.IsPseudo(&v) {
+		t.Errorf("Expected %s to be a pseudo-version", v.VString())
+	}
+
+	v, _ = semver.Parse("v1.0.0-rc.1")
+	if gomod.IsPseudo(&v) {
+		t.Errorf("Expected %s not to be a pseudo-version", v.VString())
+	}
+}
+
+func TestNewPseudo(t *testing.T) {
+	local := pseudoTime.In(time.FixedZone("test", -5*60*60))
+	hash := "daa7c04131f5e8b0a3c7b1c6f0a8d3e2b1c4a5f6"
+
+	tests := map[string]string{
+		"1.2.3":              "v1.2.4-0.20191109021931-daa7c04131f5",
+		"1.2.3-rc.1":         "v1.2.3-rc.1.0.20191109021931-daa7c04131f5",
+		"2.0.0+incompatible": "v2.0.1-0.20191109021931-daa7c04131f5+incompatible",
+	}
+
+	for base, expect := range tests {
+		t.Run(base, func(t *testing.T) {
+			b, _ := semver.ParseStrict(base)
+
+			v, err := gomod.NewPseudo(b, local, hash)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if got := v.VString(); got != expect {
+				t.Errorf("Expected %s, got %s", expect, got)
+			}
+
+			if v.Compare(&b) <= 0 {
+				t.Errorf("Expected %s to follow %s", v.VString(), b.VString())
+			}
+		})
+	}
+
+	if _, err := gomod.NewPseudo(semver.Version{}, pseudoTime, ""); err == nil {
+		t.Errorf("Expected error for empty revision")
+	}
+
+	if _, err := gomod.NewPseudo(semver.Version{}, pseudoTime, "abc/def"); err == nil {
+		t.Errorf("Expected error for invalid revision")
+	}
+
+	if _, err := gomod.NewPseudo(semver.Version{Patch: ^uint64(0)}, pseudoTime, "abc"); err != semver.ErrOverflow {
+		t.Errorf("Expected %s, got %v", semver.ErrOverflow, err)
+	}
+}
+
+func TestNewUntaggedPseudo(t *testing.T) {
+	v, err := gomod.NewUntaggedPseudo(2, pseudoTime, "daa7c04131f5")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if got, expect := v.VString(), "v2.0.0-20191109021931-daa7c04131f5"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+}
+
+func TestPseudo_Compare(t *testing.T) {
+	ordered := []string{
+		"v0.0.0-20191109021931-daa7c04131f5",
+		"v0.0.0-20201109021931-aaaaaaaaaaaa",
+		"v1.2.3",
+		"v1.2.4-0.20191109021931-daa7c04131f5",
+		"v1.2.4-0.20191110021931-000000000000",
+		"v1.2.4-rc.1",
+		"v1.2.4-rc.1.0.20191109021931-daa7c04131f5",
+		"v1.2.4",
+	}
+
+	for i := 1; i < len(ordered); i++ {
+		a, _ := semver.Parse(ordered[i-1])
+		b, _ := semver.Parse(ordered[i])
+
+		if a.Compare(&b) >= 0 {
+			t.Errorf("Expected %s < %s", ordered[i-1], ordered[i])
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package semver
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the Version as a JSON string
+// containing the value returned by String.
+//
+// To encode a Version as an object of its components, see ObjectVersion.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either a version string
+// or an object of version components as produced by ObjectVersion.
+//
+// The prerelease and build identifiers of an object are held to the same rules
+// as in ParseStrict.  An invalid identifier is reported as a *ParseError whose
+// Offset refers to the string form of the version returned by String.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	switch firstJSONByte(data) {
+	case 'n':
+		return nil
+
+	case '{':
+		var obj versionObject
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return err
+		}
+
+		out := obj.toVersion()
+		if err := validateIdentifiers(&out); err != nil {
+			return err
+		}
+
+		*v = out
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	return v.UnmarshalText([]byte(str))
+}
+
+// MarshalJSON implements json.Marshaler, encoding the version as a JSON string
+// containing the value returned by Version.VString.
+func (v VVersion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the same inputs as
+// Version.UnmarshalJSON.
+func (v *VVersion) UnmarshalJSON(data []byte) error {
+	return (*Version)(v).UnmarshalJSON(data)
+}
+
+// ObjectVersion is a Version that marshals to JSON as an object of its
+// components rather than a string, for example:
+//
+//   {"major":1,"minor":2,"patch":3,"prerelease":["rc","1"],"build":["abc"]}
+//
+// Empty prerelease and build fields are omitted.
+type ObjectVersion Version
+
+// MarshalJSON implements json.Marshaler.
+func (v ObjectVersion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(versionObject{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: v.Prerelease,
+		Build:      v.Build,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the same inputs as
+// Version.UnmarshalJSON.
+func (v *ObjectVersion) UnmarshalJSON(data []byte) error {
+	return (*Version)(v).UnmarshalJSON(data)
+}
+
+type versionObject struct {
+	Major      uint64   `json:"major"`
+	Minor      uint64   `json:"minor"`
+	Patch      uint64   `json:"patch"`
+	Prerelease []string `json:"prerelease,omitempty"`
+	Build      []string `json:"build,omitempty"`
+}
+
+func (o *versionObject) toVersion() Version {
+	return Version{
+		Major:      o.Major,
+		Minor:      o.Minor,
+		Patch:      o.Patch,
+		Prerelease: o.Prerelease,
+		Build:      o.Build,
+	}
+}
+
+// validateIdentifiers checks the prerelease and build identifiers of the given
+// version as ParseStrict would.
+func validateIdentifiers(v *Version) error {
+	vn := v.String()
+	pos := len((&Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}).String()) + 1
+
+	for _, id := range v.Prerelease {
+		if err := validateIdentifier(vn, pos, id, ComponentPrerelease); err != nil {
+			return err
+		}
+		pos += len(id) + 1
+	}
+
+	for _, id := range v.Build {
+		if err := validateIdentifier(vn, pos, id, ComponentBuild); err != nil {
+			return err
+		}
+		pos += len(id) + 1
+	}
+
+	return nil
+}
+
+// validateIdentifier checks a single identifier found at the given position
+// in the version string.
+func validateIdentifier(vn string, pos int, id string, comp Component) error {
+	switch {
+	case len(id) == 0:
+		return newParseError(vn, pos, comp, ReasonEmptyIdentifier)
+	case comp == ComponentPrerelease && len(id) > 1 && id[0] == digit0 && isNumeric(id):
+		return newParseError(vn, pos, comp, ReasonLeadingZero)
+	}
+
+	for i := 0; i < len(id); i++ {
+		if !isIdentifierChar(id[i]) {
+			return newParseError(vn, pos+i, comp, ReasonInvalidCharacter)
+		}
+	}
+
+	return nil
+}
+
+func firstJSONByte(data []byte) byte {
+	for _, b := range data {
+		if !isSpace(b) {
+			return b
+		}
+	}
+
+	return 0
+}
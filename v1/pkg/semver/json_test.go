@@ -0,0 +1,121 @@
+package semver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+var (
+	_ json.Marshaler   = semver.Version{}
+	_ json.Unmarshaler = (*semver.Version)(nil)
+	_ json.Marshaler   = semver.ObjectVersion{}
+	_ json.Unmarshaler = (*semver.ObjectVersion)(nil)
+)
+
+func TestVersion_MarshalJSON(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: []string{"rc", "1"}, Build: []string{"abc"}}
+
+	tests := []struct {
+		name   string
+		input  interface{}
+		expect string
+	}{
+		{"string", v, `"1.2.3-rc.1+abc"`},
+		{"leader", semver.VVersion(v), `"v1.2.3-rc.1+abc"`},
+		{"object", semver.ObjectVersion(v), `{"major":1,"minor":2,"patch":3,"prerelease":["rc","1"],"build":["abc"]}`},
+		{"object release", semver.ObjectVersion{Major: 4}, `{"major":4,"minor":0,"patch":0}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := json.Marshal(test.input)
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if string(out) != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, out)
+			}
+		})
+	}
+}
+
+func TestVersion_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{`"1.2.3-rc.1+abc"`, "1.2.3-rc.1+abc"},
+		{`"v1.2.3"`, "1.2.3"},
+		{`{"major":1,"minor":2,"patch":3,"prerelease":["rc","1"],"build":["abc"]}`, "1.2.3-rc.1+abc"},
+		{` {"major":4}`, "4.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var v semver.Version
+			if err := json.Unmarshal([]byte(test.input), &v); err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if v.String() != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, v.String())
+			}
+
+			var o semver.ObjectVersion
+			if err := json.Unmarshal([]byte(test.input), &o); err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if v := semver.Version(o); v.String() != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, v.String())
+			}
+		})
+	}
+}
+
+func TestVersion_UnmarshalJSON_invalid(t *testing.T) {
+	tests := []string{`"1.x"`, `{"major":-1}`, `12`, `["1.2.3"]`}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			var v semver.Version
+			if err := json.Unmarshal([]byte(test), &v); err == nil {
+				t.Error("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestVersion_UnmarshalJSON_invalidIdentifiers(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect semver.ParseError
+	}{
+		{`{"major":1,"prerelease":["a.b",""],"build":["x y"]}`,
+			semver.ParseError{7, '.', semver.ComponentPrerelease, semver.ReasonInvalidCharacter}},
+		{`{"major":1,"prerelease":["rc",""]}`,
+			semver.ParseError{9, 0, semver.ComponentPrerelease, semver.ReasonEmptyIdentifier}},
+		{`{"major":1,"prerelease":["rc","01"]}`,
+			semver.ParseError{9, '0', semver.ComponentPrerelease, semver.ReasonLeadingZero}},
+		{`{"major":1,"build":["01","x y"]}`,
+			semver.ParseError{10, ' ', semver.ComponentBuild, semver.ReasonInvalidCharacter}},
+		{`{"major":12,"prerelease":["rc"],"build":[""]}`,
+			semver.ParseError{10, 0, semver.ComponentBuild, semver.ReasonEmptyIdentifier}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var v semver.Version
+			err := json.Unmarshal([]byte(test.input), &v)
+
+			var perr *semver.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Expected *ParseError, got %v", err)
+			}
+			if *perr != test.expect {
+				t.Errorf("Expected %+v, got %+v", test.expect, *perr)
+			}
+		})
+	}
+}
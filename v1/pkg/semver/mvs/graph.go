@@ -0,0 +1,201 @@
+package mvs
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Graph is the requirement graph of a target module: every module version
+// reachable from the target through requirements, including versions that
+// are not selected.
+type Graph struct {
+	target   Module
+	modules  []Module
+	required map[string][]Module
+	selected map[string]semver.Version
+}
+
+// Load walks the requirement graph of the target module.
+func Load(target Module, reqs Reqs) (*Graph, error) {
+	g := &Graph{
+		target:   target,
+		required: make(map[string][]Module),
+		selected: map[string]semver.Version{target.Path: target.Version},
+	}
+
+	queue := []Module{target}
+	seen := map[string]bool{target.key(): true}
+
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		g.modules = append(g.modules, m)
+
+		required, err := reqs.Required(m)
+		if err != nil {
+			return nil, err
+		}
+
+		g.required[m.key()] = required
+
+		for _, r := range required {
+			if v, ok := g.selected[r.Path]; !ok || v.Compare(&r.Version) < 0 {
+				if r.Path != target.Path {
+					g.selected[r.Path] = r.Version
+				}
+			}
+
+			if !seen[r.key()] {
+				seen[r.key()] = true
+				queue = append(queue, r)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// Target returns the target module of the graph.
+func (g *Graph) Target() Module {
+	return g.target
+}
+
+// Selected returns the version of the given module path in the build list.
+// Returns false if the path is not in the graph.
+func (g *Graph) Selected(path string) (semver.Version, bool) {
+	v, ok := g.selected[path]
+	return v, ok
+}
+
+// BuildList returns the target module, followed by the selected version of
+// every other module path in the graph, sorted by path.
+func (g *Graph) BuildList() []Module {
+	out := make([]Module, 0, len(g.selected))
+	out = append(out, g.target)
+
+	for path, v := range g.selected {
+		if path != g.target.Path {
+			out = append(out, Module{Path: path, Version: v})
+		}
+	}
+
+	rest := out[1:]
+	sort.Slice(rest, func(i, j int) bool {
+		return rest[i].Path < rest[j].Path
+	})
+
+	return out
+}
+
+// Required returns the modules directly required by the given module version,
+// or nil if it is not in the graph.
+func (g *Graph) Required(m Module) []Module {
+	return g.required[m.key()]
+}
+
+// RequiredBy returns every module version in the graph directly requiring
+// exactly the given module version, in the order they were loaded.
+func (g *Graph) RequiredBy(m Module) []Module {
+	var out []Module
+
+	for _, from := range g.modules {
+		for _, r := range g.required[from.key()] {
+			if r.key() == m.key() {
+				out = append(out, from)
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+// Why explains why the given module path is selected at its version in the
+// build list.  It returns the shortest chain of requirements from the target
+// to the selected version of the path, beginning with the target and ending
+// with the selected module version.
+//
+// Versions that are not selected may appear along the chain, as their
+// requirements still take part in selection.  Returns false if the path is not
+// in the graph.
+func (g *Graph) Why(path string) ([]Module, bool) {
+	v, ok := g.selected[path]
+	if !ok {
+		return nil, false
+	}
+
+	goal := Module{Path: path, Version: v}
+	if goal.key() == g.target.key() {
+		return []Module{g.target}, true
+	}
+
+	parent := map[string]Module{}
+	queue := []Module{g.target}
+	seen := map[string]bool{g.target.key(): true}
+
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+
+		for _, r := range g.required[m.key()] {
+			if seen[r.key()] {
+				continue
+			}
+
+			seen[r.key()] = true
+			parent[r.key()] = m
+
+			if r.key() == goal.key() {
+				chain := []Module{r}
+				for cur := m; ; cur = parent[cur.key()] {
+					chain = append(chain, cur)
+					if cur.key() == g.target.key() {
+						break
+					}
+				}
+
+				for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+					chain[i], chain[j] = chain[j], chain[i]
+				}
+
+				return chain, true
+			}
+
+			queue = append(queue, r)
+		}
+	}
+
+	return nil, false
+}
+
+// WriteTo writes the graph in the format of "go mod graph": one line per
+// requirement, holding the requiring and required module versions separated
+// by a space.  The target is written by path alone.
+func (g *Graph) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, g.String())
+	return int64(n), err
+}
+
+// String returns the graph in the format written by WriteTo.
+func (g *Graph) String() string {
+	var b strings.Builder
+
+	for _, m := range g.modules {
+		from := m.String()
+		if m.key() == g.target.key() {
+			from = m.Path
+		}
+
+		for _, r := range g.required[m.key()] {
+			b.WriteString(from)
+			b.WriteByte(' ')
+			b.WriteString(r.String())
+			b.WriteByte('\n')
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package mvs
+
+import (
+	"sort"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// MemoryReqs is a DowngradeReqs holding a requirement graph in memory,
+// intended for tests.
+//
+// The zero value is an empty graph ready for use.
+type MemoryReqs struct {
+	required map[string][]Module
+	versions map[string][]semver.Version
+}
+
+// Add adds a module version with the given requirements, replacing the
+// requirements of the module version if it was already added.
+func (m *MemoryReqs) Add(mod Module, required ...Module) {
+	if m.required == nil {
+		m.required = make(map[string][]Module)
+		m.versions = make(map[string][]semver.Version)
+	}
+
+	if _, ok := m.required[mod.key()]; !ok {
+		versions := append(m.versions[mod.Path], mod.Version)
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].Compare(&versions[j]) < 0
+		})
+		m.versions[mod.Path] = versions
+	}
+
+	m.required[mod.key()] = required
+}
+
+// Required implements Reqs.  A module version that was not added has no
+// requirements.
+func (m *MemoryReqs) Required(mod Module) ([]Module, error) {
+	return m.required[mod.key()], nil
+}
+
+// Previous implements DowngradeReqs, returning the highest added version of the
+// module's path below the module's version.
+func (m *MemoryReqs) Previous(mod Module) (semver.Version, bool, error) {
+	versions := m.versions[mod.Path]
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].Compare(&mod.Version) < 0 {
+			return versions[i], true, nil
+		}
+	}
+
+	return semver.Version{}, false, nil
+}
@@ -0,0 +1,226 @@
+// Package mvs implements Minimal Version Selection, the algorithm used by Go
+// modules to select the versions of the modules making up a build.
+//
+// Each module version lists the minimum versions of the modules it requires.
+// The build list of a target module holds, for every module reachable through
+// those requirements, the highest version required of it anywhere in the
+// requirement graph.  This matches the semantics of "go mod graph" and "go list
+// -m all" without module graph pruning.
+package mvs
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+// Module is a specific version of a module path.
+type Module struct {
+	Path    string
+	Version semver.Version
+}
+
+// ParseModule parses a module in the form "path@v1.2.3".
+func ParseModule(s string) (Module, error) {
+	at := strings.LastIndexByte(s, '@')
+	if at < 1 || at+1 >= len(s) || s[at+1] != 'v' {
+		return Module{}, errors.New("mvs: invalid module " + s + ": expected path@vX.Y.Z")
+	}
+
+	v, err := semver.ParseStrict(s[at+2:])
+	if err != nil {
+		return Module{}, err
+	}
+
+	return Module{Path: s[:at], Version: v}, nil
+}
+
+// MustParseModule is like ParseModule but panics if the module cannot be
+// parsed.
+func MustParseModule(s string) Module {
+	m, err := ParseModule(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// String returns the module in the form "path@v1.2.3".
+func (m Module) String() string {
+	return m.Path + "@" + m.Version.VString()
+}
+
+// key identifies a module version, treating versions of equal precedence as
+// the same version.
+func (m Module) key() string {
+	v := semver.Version{Major: m.Version.Major, Minor: m.Version.Minor, Patch: m.Version.Patch,
+		Prerelease: m.Version.Prerelease}
+
+	return m.Path + "@" + v.String()
+}
+
+// Reqs provides the requirement graph.
+type Reqs interface {
+	// Required returns the modules directly required by the given module
+	// version.
+	Required(m Module) ([]Module, error)
+}
+
+// DowngradeReqs is a Reqs that can also list earlier versions of a module.
+type DowngradeReqs interface {
+	Reqs
+
+	// Previous returns the version of the module's path immediately preceding
+	// the module's version.  Returns false if there is no earlier version.
+	Previous(m Module) (semver.Version, bool, error)
+}
+
+// BuildList returns the build list of the target module: the target itself,
+// followed by the selected version of every other module in its requirement
+// graph, sorted by path.
+func BuildList(target Module, reqs Reqs) ([]Module, error) {
+	g, err := Load(target, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.BuildList(), nil
+}
+
+// Upgrade returns the build list of the target module as if the target also
+// required each of the given modules, upgrading them and any modules they
+// require.  Modules are never downgraded by Upgrade.
+func Upgrade(target Module, reqs Reqs, upgrade ...Module) ([]Module, error) {
+	direct, err := reqs.Required(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildList(target, &override{reqs, target, append(append([]Module(nil), direct...), upgrade...)})
+}
+
+// Downgrade returns the build list of the target module with each of the given
+// modules at or below the given version.
+//
+// Every other module version in the build list requiring a version of a module
+// higher than permitted is downgraded, using Previous, to the highest version
+// whose requirements are all permitted, or removed from the build list if no
+// such version exists.  Modules are never upgraded by Downgrade.
+func Downgrade(target Module, reqs DowngradeReqs, downgrade ...Module) ([]Module, error) {
+	list, err := BuildList(target, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := make(map[string]semver.Version, len(list))
+	for _, m := range list[1:] {
+		limit[m.Path] = m.Version
+	}
+
+	for _, d := range downgrade {
+		if v, ok := limit[d.Path]; !ok || d.Version.Compare(&v) < 0 {
+			limit[d.Path] = d.Version
+		}
+	}
+
+	added := make(map[string]bool)
+	excluded := make(map[string]bool)
+	requiredBy := make(map[string][]Module)
+
+	var exclude func(m Module)
+	exclude = func(m Module) {
+		if excluded[m.key()] {
+			return
+		}
+
+		excluded[m.key()] = true
+
+		for _, p := range requiredBy[m.key()] {
+			exclude(p)
+		}
+	}
+
+	var add func(m Module) error
+	add = func(m Module) error {
+		if added[m.key()] {
+			return nil
+		}
+
+		added[m.key()] = true
+
+		if v, ok := limit[m.Path]; ok && v.Compare(&m.Version) < 0 {
+			exclude(m)
+			return nil
+		}
+
+		required, err := reqs.Required(m)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range required {
+			if err := add(r); err != nil {
+				return err
+			}
+
+			requiredBy[r.key()] = append(requiredBy[r.key()], m)
+
+			if excluded[r.key()] {
+				exclude(m)
+				return nil
+			}
+		}
+
+		return nil
+	}
+
+	for _, m := range list[1:] {
+		if err := add(m); err != nil {
+			return nil, err
+		}
+	}
+
+	var kept []Module
+
+	for _, m := range list[1:] {
+		for excluded[m.key()] {
+			prev, ok, err := reqs.Previous(m)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				break
+			}
+
+			m = Module{Path: m.Path, Version: prev}
+
+			if err := add(m); err != nil {
+				return nil, err
+			}
+		}
+
+		if !excluded[m.key()] {
+			kept = append(kept, m)
+		}
+	}
+
+	return BuildList(target, &override{reqs, target, kept})
+}
+
+// override is a Reqs replacing the requirements of a single module.
+type override struct {
+	Reqs
+	target   Module
+	required []Module
+}
+
+func (o *override) Required(m Module) ([]Module, error) {
+	if m.key() == o.target.key() {
+		return o.required, nil
+	}
+
+	return o.Reqs.Required(m)
+}
@@ -0,0 +1,249 @@
+package mvs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver/mvs"
+)
+
+// testReqs builds the following requirement graph, in which each module path
+// is a single letter:
+//
+//	a@v1.0.0 -> b@v1.2.0, c@v1.2.0
+//	b@v1.1.0 -> d@v1.1.0
+//	b@v1.2.0 -> d@v1.3.0
+//	c@v1.1.0
+//	c@v1.2.0 -> d@v1.4.0
+//	c@v1.3.0 -> f@v1.1.0
+//	d@v1.1.0 -> e@v1.1.0
+//	d@v1.2.0 -> e@v1.1.0
+//	d@v1.3.0 -> e@v1.2.0
+//	d@v1.4.0 -> e@v1.2.0
+//	e@v1.1.0, e@v1.2.0, e@v1.3.0
+//	f@v1.1.0 -> g@v1.1.0
+//	g@v1.1.0 -> f@v1.1.0
+func testReqs() *mvs.MemoryReqs {
+	graph := map[string][]string{
+		"a@v1.0.0": {"b@v1.2.0", "c@v1.2.0"},
+		"b@v1.1.0": {"d@v1.1.0"},
+		"b@v1.2.0": {"d@v1.3.0"},
+		"c@v1.1.0": nil,
+		"c@v1.2.0": {"d@v1.4.0"},
+		"c@v1.3.0": {"f@v1.1.0"},
+		"d@v1.1.0": {"e@v1.1.0"},
+		"d@v1.2.0": {"e@v1.1.0"},
+		"d@v1.3.0": {"e@v1.2.0"},
+		"d@v1.4.0": {"e@v1.2.0"},
+		"e@v1.1.0": nil,
+		"e@v1.2.0": nil,
+		"e@v1.3.0": nil,
+		"f@v1.1.0": {"g@v1.1.0"},
+		"g@v1.1.0": {"f@v1.1.0"},
+	}
+
+	reqs := new(mvs.MemoryReqs)
+	for m, required := range graph {
+		reqs.Add(mvs.MustParseModule(m), modules(required...)...)
+	}
+
+	return reqs
+}
+
+func modules(in ...string) []mvs.Module {
+	out := make([]mvs.Module, len(in))
+	for i, s := range in {
+		out[i] = mvs.MustParseModule(s)
+	}
+	return out
+}
+
+func render(list []mvs.Module) string {
+	parts := make([]string, len(list))
+	for i, m := range list {
+		parts[i] = m.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+var target = mvs.MustParseModule("a@v1.0.0")
+
+func TestBuildList(t *testing.T) {
+	list, err := mvs.BuildList(target, testReqs())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if got, expect := render(list), "a@v1.0.0 b@v1.2.0 c@v1.2.0 d@v1.4.0 e@v1.2.0"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+}
+
+func TestBuildList_Cycle(t *testing.T) {
+	list, err := mvs.BuildList(mvs.MustParseModule("f@v1.1.0"), testReqs())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if got, expect := render(list), "f@v1.1.0 g@v1.1.0"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		upgrade []string
+		expect  string
+	}{
+		"single": {
+			[]string{"c@v1.3.0"},
+			"a@v1.0.0 b@v1.2.0 c@v1.3.0 d@v1.4.0 e@v1.2.0 f@v1.1.0 g@v1.1.0",
+		},
+		"transitive": {
+			[]string{"e@v1.3.0"},
+			"a@v1.0.0 b@v1.2.0 c@v1.2.0 d@v1.4.0 e@v1.3.0",
+		},
+		"never downgrades": {
+			[]string{"d@v1.1.0"},
+			"a@v1.0.0 b@v1.2.0 c@v1.2.0 d@v1.4.0 e@v1.2.0",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			list, err := mvs.Upgrade(target, testReqs(), modules(test.upgrade...)...)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if got := render(list); got != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestDowngrade(t *testing.T) {
+	tests := map[string]struct {
+		downgrade []string
+		expect    string
+	}{
+		"dependency": {
+			[]string{"d@v1.2.0"},
+			"a@v1.0.0 b@v1.1.0 c@v1.1.0 d@v1.2.0 e@v1.2.0",
+		},
+		"direct": {
+			[]string{"c@v1.1.0"},
+			"a@v1.0.0 b@v1.2.0 c@v1.1.0 d@v1.4.0 e@v1.2.0",
+		},
+		"removes": {
+			[]string{"d@v1.0.0"},
+			"a@v1.0.0 c@v1.1.0 e@v1.2.0",
+		},
+		"never upgrades": {
+			[]string{"c@v1.3.0"},
+			"a@v1.0.0 b@v1.2.0 c@v1.2.0 d@v1.4.0 e@v1.2.0",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			list, err := mvs.Downgrade(target, testReqs(), modules(test.downgrade...)...)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if got := render(list); got != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestGraph_Why(t *testing.T) {
+	g, err := mvs.Load(target, testReqs())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	tests := map[string]string{
+		"a": "a@v1.0.0",
+		"c": "a@v1.0.0 c@v1.2.0",
+		"d": "a@v1.0.0 c@v1.2.0 d@v1.4.0",
+		"e": "a@v1.0.0 b@v1.2.0 d@v1.3.0 e@v1.2.0",
+	}
+
+	for path, expect := range tests {
+		t.Run(path, func(t *testing.T) {
+			chain, ok := g.Why(path)
+			if !ok {
+				t.Fatalf("Expected %s to be in the graph", path)
+			}
+
+			if got := render(chain); got != expect {
+				t.Errorf("Expected %s, got %s", expect, got)
+			}
+		})
+	}
+
+	if _, ok := g.Why("f"); ok {
+		t.Errorf("Expected f not to be in the graph")
+	}
+}
+
+func TestGraph_RequiredBy(t *testing.T) {
+	g, err := mvs.Load(target, testReqs())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if got, expect := render(g.RequiredBy(mvs.MustParseModule("e@v1.2.0"))), "d@v1.3.0 d@v1.4.0"; got != expect {
+		t.Errorf("Expected %s, got %s", expect, got)
+	}
+
+	if v, ok := g.Selected("d"); !ok || v.String() != "1.4.0" {
+		t.Errorf("Expected d at 1.4.0, got %s", v.String())
+	}
+}
+
+func TestGraph_String(t *testing.T) {
+	g, err := mvs.Load(target, testReqs())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expect := "a b@v1.2.0\n" +
+		"a c@v1.2.0\n" +
+		"b@v1.2.0 d@v1.3.0\n" +
+		"c@v1.2.0 d@v1.4.0\n" +
+		"d@v1.3.0 e@v1.2.0\n" +
+		"d@v1.4.0 e@v1.2.0\n"
+
+	if got := g.String(); got != expect {
+		t.Errorf("Expected %q, got %q", expect, got)
+	}
+}
+
+func TestParseModule(t *testing.T) {
+	tests := map[string]bool{
+		"example.com/a@v1.2.3":      true,
+		"example.com/a@v1.2.3-rc.1": true,
+		"example.com/a@1.2.3":       false,
+		"example.com/a":             false,
+		"@v1.2.3":                   false,
+		"example.com/a@v1.2":        false,
+	}
+
+	for input, ok := range tests {
+		t.Run(input, func(t *testing.T) {
+			m, err := mvs.ParseModule(input)
+			if (err == nil) != ok {
+				t.Fatalf("Expected ok %t, got error %v", ok, err)
+			}
+
+			if ok && m.String() != input {
+				t.Errorf("Expected %s, got %s", input, m.String())
+			}
+		})
+	}
+}
@@ -1,18 +1,10 @@
 package semver
 
 import (
-	"unsafe"
-
 	"github.com/foxcapades/gVersion/v1/internal/util"
 )
 
-var (
-	errInvalidSemVerString = "invalid format for a semantic version string"
-)
-
 const (
-	parseBufferSize = uint8(64)
-
 	segDivider   uint8 = '.'
 	preDivider   uint8 = '-'
 	buildDivider uint8 = '+'
@@ -23,158 +15,185 @@ const (
 	leader uint8 = 'v'
 )
 
+// Parse parses the given string as a semantic version.
+//
+// Parse is lenient, allowing a leading 'v' character and omitted components.
+// For validation against the SemVer 2.0.0 grammar, see ParseStrict.
+//
+// The prerelease and build identifiers of the returned Version are substrings
+// of the input string.
 func Parse(versionString string) (version Version, err error) {
-	input := roBytes(&versionString)
-	pos := uint8(0)
+	err = parse(versionString, &version)
+	return
+}
+
+// ParseBytes parses the given bytes as a semantic version following the same
+// rules as Parse.
+//
+// The input is not retained, and may be modified after ParseBytes returns.  The
+// major, minor, and patch numbers are parsed without copying the input; any
+// prerelease and build identifiers are copied together in a single allocation.
+func ParseBytes(versionBytes []byte) (version Version, err error) {
+	err = parseBytes(versionBytes, &version)
+	return
+}
+
+// ParseBytesInto parses the given bytes as a semantic version following the
+// same rules as ParseBytes, storing the result in the given Version.
+//
+// The backing arrays of the given Version's Prerelease and Build slices are
+// reused when large enough, as in ParseInto.  Versions without prerelease or
+// build identifiers are parsed without allocating.
+func ParseBytesInto(dst *Version, versionBytes []byte) error {
+	return parseBytes(versionBytes, dst)
+}
+
+// ParseInto parses the given string as a semantic version following the same
+// rules as Parse, storing the result in the given Version.
+//
+// The backing arrays of the given Version's Prerelease and Build slices are
+// reused when large enough, allowing repeated parsing into the same Version
+// without allocating.
+func ParseInto(dst *Version, versionString string) error {
+	return parse(versionString, dst)
+}
+
+func parse(in string, ver *Version) error {
+	pre, build := ver.Prerelease[:0], ver.Build[:0]
+	*ver = Version{}
+
+	if len(in) == 0 {
+		return &ParseError{Reason: ReasonEmpty}
+	}
+
+	pos := 0
 
 	// Skip leading character if it's present.
-	if input[0] == leader {
+	if in[0] == leader {
 		pos++
 	}
 
-	buf := [parseBufferSize]byte{}
-	ln := uint8(len(input))
+	if err := parseVersions(in, &pos, ver); err != nil {
+		return err
+	}
+
+	parseIdentifiers(in, pos, ver, pre, build)
+
+	return nil
+}
 
-	err = parseVersions(input, &pos, &buf, &version)
-	if err != nil {
-		return version, err
+// parseBytes parses the given bytes as parse does.  Only the prerelease and
+// build identifiers, which are held as strings, are copied out of the input.
+func parseBytes(in []byte, ver *Version) error {
+	pre, build := ver.Prerelease[:0], ver.Build[:0]
+	*ver = Version{}
+
+	if len(in) == 0 {
+		return &ParseError{Reason: ReasonEmpty}
 	}
 
-	if pos < ln && input[pos] == preDivider {
+	pos, core := 0, 0
+
+	if in[0] == leader {
 		pos++
-		parsePrerelease(input, &pos, &buf, &version)
 	}
 
-	if pos < ln && input[pos] == buildDivider {
+	for core = pos; core < len(in); core++ {
+		if in[core] == preDivider || in[core] == buildDivider {
+			break
+		}
+	}
+
+	// The converted core does not outlive parseVersions, so for short inputs
+	// the conversion is made on the stack.
+	if err := parseVersions(string(in[:core]), &pos, ver); err != nil {
+		return err
+	}
+
+	if core < len(in) {
+		parseIdentifiers(string(in[core:]), 0, ver, pre, build)
+	}
+
+	return nil
+}
+
+// parseIdentifiers reads the prerelease and build identifiers, if any, starting
+// at the given position into the given Version, reusing the given slices.
+func parseIdentifiers(in string, pos int, ver *Version, pre, build []string) {
+	if pos < len(in) && in[pos] == preDivider {
 		pos++
-		parseBuild(input, &pos, &buf, &version)
+		ver.Prerelease = splitIdentifiers(in, &pos, true, pre)
 	}
 
-	return
+	if pos < len(in) && in[pos] == buildDivider {
+		pos++
+		ver.Build = splitIdentifiers(in, &pos, false, build)
+	}
 }
 
 const vSegs = 3
-func parseVersions(vn []byte, pos *uint8, buf *[parseBufferSize]byte, ver *Version) error {
-	parts := [vSegs]*uint8{&ver.Major, &ver.Minor, &ver.Patch}
+func parseVersions(vn string, pos *int, ver *Version) error {
+	parts := [vSegs]*uint64{&ver.Major, &ver.Minor, &ver.Patch}
 
-	pp := uint8(0)
-	ln := uint8(len(vn))
-	bp := 0
+	pp := 0
+	ln := len(vn)
 
 	for ; *pos < ln; *pos++ {
-		if pp >= vSegs {
-			return parseError{1, errInvalidSemVerString}
-		}
-
 		switch true {
 
 		case vn[*pos] >= digit0 && vn[*pos] <= digit9:
-			buf[bp] = vn[*pos] - '0'
-			bp++
+			var ok bool
+			if *parts[pp], ok = util.ShiftDigitU64(*parts[pp], vn[*pos]-digit0); !ok {
+				return &ParseError{*pos, vn[*pos], ComponentMajor + Component(pp), ReasonOverflow}
+			}
 
 		case vn[*pos] == segDivider:
-			*parts[pp] = bufToU8(buf[:bp])
+			if pp == vSegs-1 {
+				return &ParseError{*pos, vn[*pos], ComponentPatch, ReasonTooManyComponents}
+			}
 			pp++
-			bp = 0
 
 		default:
 			switch vn[*pos] {
 			case preDivider, buildDivider:
 				return nil
 			default:
-				return parseError{2, errInvalidSemVerString}
+				return &ParseError{*pos, vn[*pos], ComponentMajor + Component(pp), ReasonInvalidCharacter}
 			}
 		}
 	}
 
-	*parts[pp] = bufToU8(buf[:bp])
-
 	return nil
 }
 
-func parsePrerelease(vn []byte, pos *uint8, buf *[parseBufferSize]byte, ver *Version) {
-	segments := uint8(1)
-	preLen := uint8(len(vn))
+// splitIdentifiers splits the dot separated identifiers starting at the given
+// position into the given slice, reusing its backing array if it is large
+// enough.  Prerelease identifiers end at the first '+' character, build
+// identifiers end at the end of the input.
+func splitIdentifiers(vn string, pos *int, pre bool, out []string) []string {
+	segments := 1
+	end := *pos
 
-	for i := *pos; i < preLen; i++ {
-		if vn[i] == segDivider {
+	for ; end < len(vn); end++ {
+		if vn[end] == segDivider {
 			segments++
-		} else if vn[i] == buildDivider {
+		} else if pre && vn[end] == buildDivider {
 			break
 		}
 	}
 
-	bufPos := 0
-	segIndex := 0
-	ver.Prerelease = make([]string, segments)
-
-	for ; *pos < preLen; *pos++ {
-		if vn[*pos] == segDivider {
-			ver.Prerelease[segIndex] = string(buf[:bufPos])
-			segIndex++
-			bufPos = 0
-		} else if vn[*pos] == buildDivider {
-			break
-		} else {
-			buf[bufPos] = vn[*pos]
-			bufPos++
-		}
-	}
-
-	ver.Prerelease[segIndex] = string(buf[:bufPos])
-}
-
-func parseBuild(vn []byte, pos *uint8, buf *[parseBufferSize]byte, ver *Version) {
-	segments := uint8(1)
-	buildLen := uint8(len(vn))
-
-	for i := *pos; i < buildLen; i++ {
-		if vn[i] == segDivider {
-			segments++
-		}
+	if cap(out) < segments {
+		out = make([]string, 0, segments)
 	}
 
-	ver.Build = make([]string, segments)
+	start := *pos
 
-	bufPos := 0
-	segIndex := 0
-	ver.Build = make([]string, segments)
-
-	for ; *pos < buildLen; *pos++ {
+	for ; *pos < end; *pos++ {
 		if vn[*pos] == segDivider {
-			ver.Build[segIndex] = string(buf[:bufPos])
-			segIndex++
-			bufPos = 0
-		} else {
-			buf[bufPos] = vn[*pos]
-			bufPos++
+			out = append(out, vn[start:*pos])
+			start = *pos + 1
 		}
 	}
 
-	ver.Build[segIndex] = string(buf[:bufPos])
-}
-
-func bufToU8(buf []byte) (val uint8) {
-	up := uint8(0)
-
-
-	for dn := int8(len(buf)) - 1; dn >= 0; dn-- {
-		val += buf[dn] * util.PowU8(10, up)
-		up++
-	}
-
-	return
-}
-
-func roBytes(str *string) []byte {
-	return *(*[]byte)(unsafe.Pointer(str))
-}
-
-type parseError struct {
-	code uint8
-	err string
-}
-
-func (p parseError) Error() string {
-	return p.err + " code " + string(p.code + '0')
+	return append(out, vn[start:end])
 }
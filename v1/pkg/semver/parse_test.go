@@ -1,7 +1,9 @@
 package semver_test
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/foxcapades/gVersion/v1/pkg/semver"
@@ -26,6 +28,10 @@ func TestParse(t *testing.T) {
 		{"v10.10.0", semver.Version{Major: 10, Minor: 10}},
 		{"v10.10.10", semver.Version{Major: 10, Minor: 10, Patch: 10}},
 
+		{"v1.300.0", semver.Version{Major: 1, Minor: 300}},
+		{"v2024.1.20240115", semver.Version{Major: 2024, Minor: 1, Patch: 20240115}},
+		{"v18446744073709551615.0.0", semver.Version{Major: 18446744073709551615}},
+
 		{"v0.0.0-alpha", semver.Version{Prerelease: []string{"alpha"}}},
 		{"v0.0.0-alpha.v1", semver.Version{Prerelease: []string{"alpha", "v1"}}},
 		{"v0.0.0+2020-09-18", semver.Version{Build: []string{"2020-09-18"}}},
@@ -92,10 +98,11 @@ func TestParse(t *testing.T) {
 
 func TestParse1(t *testing.T) {
 	tests := [][2]string {
-		{"va.0.1", "invalid format for a semantic version string code 2"},
-		{"v0.a.0", "invalid format for a semantic version string code 2"},
-		{"v1.0.a", "invalid format for a semantic version string code 2"},
-		{"v1.0.0.1", "invalid format for a semantic version string code 1"},
+		{"va.0.1", "invalid semantic version string: invalid character 'a' in major version at offset 1"},
+		{"v0.a.0", "invalid semantic version string: invalid character 'a' in minor version at offset 3"},
+		{"v1.0.a", "invalid semantic version string: invalid character 'a' in patch version at offset 5"},
+		{"v1.0.0.1", "invalid semantic version string: too many numeric components in patch version at offset 6"},
+		{"", "invalid semantic version string: empty version string at offset 0"},
 	}
 
 	for _, test := range tests {
@@ -110,6 +117,137 @@ func TestParse1(t *testing.T) {
 	}
 }
 
+func TestParse_overflow(t *testing.T) {
+	tests := []struct {
+		input     string
+		component semver.Component
+	}{
+		{"v18446744073709551616.0.0", semver.ComponentMajor},
+		{"v0.99999999999999999999.0", semver.ComponentMinor},
+		{"v0.0.18446744073709551620", semver.ComponentPatch},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			_, err := semver.Parse(test.input)
+
+			var pe *semver.ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("Expected a *ParseError, got %#v", err)
+			}
+			if pe.Reason != semver.ReasonOverflow {
+				t.Errorf("Expected reason %s, got %s", semver.ReasonOverflow, pe.Reason)
+			}
+			if pe.Component != test.component {
+				t.Errorf("Expected component %s, got %s", test.component, pe.Component)
+			}
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	input := []byte("v1.2.3-rc.1+b5")
+
+	ver, err := semver.ParseBytes(input)
+	if err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+
+	copy(input, "xxxxxxxxxxxxxx")
+
+	if ver.String() != "1.2.3-rc.1+b5" {
+		t.Errorf("Expected 1.2.3-rc.1+b5, got %s", ver.String())
+	}
+}
+
+func TestParseInto(t *testing.T) {
+	var ver semver.Version
+
+	if err := semver.ParseInto(&ver, "1.2.3-alpha.1.x+b1.b2"); err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+	if ver.String() != "1.2.3-alpha.1.x+b1.b2" {
+		t.Errorf("Expected 1.2.3-alpha.1.x+b1.b2, got %s", ver.String())
+	}
+
+	pre := &ver.Prerelease[0]
+
+	if err := semver.ParseInto(&ver, "4.5.6-rc.2"); err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+	if ver.String() != "4.5.6-rc.2" {
+		t.Errorf("Expected 4.5.6-rc.2, got %s", ver.String())
+	}
+	if &ver.Prerelease[0] != pre {
+		t.Error("Expected ParseInto to reuse the prerelease slice")
+	}
+}
+
+func TestParseInto_allocs(t *testing.T) {
+	inputs := []string{"v1.2.3", "1.2.3-rc.1+b5", "v0.0.0-alpha.v1+2020-09-18.b21"}
+
+	for _, input := range inputs {
+		var ver semver.Version
+		_ = semver.ParseInto(&ver, input)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = semver.ParseInto(&ver, input)
+		})
+
+		if allocs != 0 {
+			t.Errorf("Expected no allocations parsing %s, got %f", input, allocs)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		hold, _ = semver.Parse("v1.23.456")
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected Parse to make no allocations, got %f", allocs)
+	}
+}
+
+func TestParseBytesInto_allocs(t *testing.T) {
+	tests := []struct {
+		input  string
+		allocs float64
+	}{
+		{"v1.2.3", 0},
+		{"18446744073709551615.0.1", 0},
+		{"1.2.3-rc.1+b5", 1},
+		{"v0.0.0-alpha.v1+2020-09-18.b21", 1},
+	}
+
+	for _, test := range tests {
+		input := []byte(test.input)
+
+		var ver semver.Version
+		_ = semver.ParseBytesInto(&ver, input)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = semver.ParseBytesInto(&ver, input)
+		})
+
+		if allocs != test.allocs {
+			t.Errorf("Expected %f allocations parsing %s, got %f", test.allocs, test.input, allocs)
+		}
+
+		if ver.String() != strings.TrimPrefix(test.input, "v") {
+			t.Errorf("Expected %s, got %s", strings.TrimPrefix(test.input, "v"), ver.String())
+		}
+	}
+
+	input := []byte("v1.23.456")
+	allocs := testing.AllocsPerRun(100, func() {
+		hold, _ = semver.ParseBytes(input)
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected ParseBytes to make no allocations, got %f", allocs)
+	}
+}
+
 var hold semver.Version
 func Benchmark(b *testing.B) {
 	benchmarks := []string {
@@ -154,3 +292,19 @@ func ExampleParse() {
 	// [alpha]
 	// [b58]
 }
+
+func BenchmarkParseInto(b *testing.B) {
+	benchmarks := []string {
+		"v1.1.1",
+		"v0.0.0-alpha.v1+2020-09-18.b21",
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = semver.ParseInto(&hold, bm)
+			}
+		})
+	}
+}
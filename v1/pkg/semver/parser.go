@@ -0,0 +1,41 @@
+package semver
+
+// Parser is a configurable version string parser.
+//
+// The zero value behaves the same as Parse.
+type Parser struct {
+	// MaxLength is the maximum length in bytes of an accepted version string.
+	// Longer inputs are rejected with ReasonTooLong before any parsing is done.
+	//
+	// A value of zero or less means inputs of any length are accepted.
+	MaxLength int
+
+	// Strict enables validation against the SemVer 2.0.0 grammar as performed
+	// by ParseStrict.
+	Strict bool
+}
+
+// Parse parses the given string as a semantic version using this Parser's
+// configuration.
+func (p Parser) Parse(versionString string) (version Version, err error) {
+	err = p.ParseInto(&version, versionString)
+	return
+}
+
+// ParseInto parses the given string as a semantic version using this Parser's
+// configuration, storing the result in the given Version.
+//
+// When Strict is false the backing arrays of the given Version's Prerelease
+// and Build slices are reused as described by the ParseInto function.
+func (p Parser) ParseInto(dst *Version, versionString string) (err error) {
+	if p.MaxLength > 0 && len(versionString) > p.MaxLength {
+		return newParseError(versionString, p.MaxLength, ComponentNone, ReasonTooLong)
+	}
+
+	if p.Strict {
+		*dst, err = ParseStrict(versionString)
+		return
+	}
+
+	return parse(versionString, dst)
+}
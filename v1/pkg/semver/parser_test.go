@@ -0,0 +1,60 @@
+package semver_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func TestParser_Parse(t *testing.T) {
+	p := semver.Parser{MaxLength: 16}
+
+	if v, err := p.Parse("v1.2.3-rc.1+b5"); err != nil || v.String() != "1.2.3-rc.1+b5" {
+		t.Errorf("Expected 1.2.3-rc.1+b5, got %s, %v", v.String(), err)
+	}
+
+	_, err := p.Parse("1.2.3-rc.1+build.2024")
+
+	var pe *semver.ParseError
+	if !errors.As(err, &pe) || pe.Reason != semver.ReasonTooLong || pe.Offset != 16 {
+		t.Errorf("Expected a too long error at offset 16, got %v", err)
+	}
+
+	p.Strict = true
+	if _, err := p.Parse("v1.2.3"); !errors.Is(err, semver.ReasonMissingNumber) {
+		t.Errorf("Expected strict parsing to reject a leading v, got %v", err)
+	}
+}
+
+func TestParse_longInputs(t *testing.T) {
+	sha := "3f786850e387550fdab836ed7e6dc881de23001b"
+	tests := []string{
+		"1.2.3+" + sha + "-20240115T120000Z",
+		"1.2.3-" + strings.Repeat("a", 65),
+		"1.2.3-ci." + strings.Repeat("x", 300) + "+" + strings.Repeat("b.", 200) + "c",
+		"1.2.3-" + strings.Repeat("9", 70),
+		"1.2.3+" + strings.Repeat("z", 100000),
+	}
+
+	for _, test := range tests {
+		for name, parse := range map[string]func(string) (semver.Version, error){
+			"Parse":       semver.Parse,
+			"ParseStrict": semver.ParseStrict,
+			"Parser":      semver.Parser{}.Parse,
+		} {
+			v, err := parse(test)
+			if err != nil {
+				t.Errorf("%s: expected no error for %d byte input, got %s", name, len(test), err)
+			} else if v.String() != test {
+				t.Errorf("%s: expected %d byte input to round trip", name, len(test))
+			}
+		}
+
+		v, _, err := semver.Coerce(test)
+		if err != nil || v.String() != test {
+			t.Errorf("Coerce: expected %d byte input to round trip, got %v", len(test), err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package resolve
+
+import (
+	"strconv"
+	"strings"
+)
+
+type cause uint8
+
+const (
+	// causeRoot marks the incompatibility requiring the root package.
+	causeRoot cause = iota
+
+	// causeDependency marks an incompatibility between a package version and
+	// the versions of a dependency it does not allow.
+	causeDependency
+
+	// causeNoVersions marks an incompatibility forbidding a set of versions
+	// of which none exist.
+	causeNoVersions
+
+	// causeConflict marks an incompatibility derived from two others during
+	// conflict resolution.
+	causeConflict
+)
+
+// incompatibility is a set of terms that must not all be true at once.
+type incompatibility struct {
+	terms []term
+	cause cause
+
+	// conflict and other are the incompatibilities an incompatibility of
+	// causeConflict was derived from.
+	conflict *incompatibility
+	other    *incompatibility
+}
+
+func newIncompatibility(terms []term, c cause, conflict, other *incompatibility) *incompatibility {
+	// The root package is always selected, so positive terms for it add nothing
+	// to a derived incompatibility.
+	if c == causeConflict && len(terms) != 1 {
+		kept := terms[:0:0]
+		for _, t := range terms {
+			if !(t.positive && t.pkg == rootPackage) {
+				kept = append(kept, t)
+			}
+		}
+		terms = kept
+	}
+
+	merged := make([]term, 0, len(terms))
+
+outer:
+	for _, t := range terms {
+		for i := range merged {
+			if merged[i].pkg == t.pkg {
+				merged[i] = merged[i].intersect(t)
+				continue outer
+			}
+		}
+
+		merged = append(merged, t)
+	}
+
+	return &incompatibility{terms: merged, cause: c, conflict: conflict, other: other}
+}
+
+// isFailure returns whether the incompatibility proves no solution exists.
+func (i *incompatibility) isFailure() bool {
+	return len(i.terms) == 0 || len(i.terms) == 1 && i.terms[0].positive && i.terms[0].pkg == rootPackage
+}
+
+func (i *incompatibility) String() string {
+	switch i.cause {
+	case causeDependency:
+		return i.terms[0].describe(true) + " depends on " + i.terms[1].describe(false)
+	case causeNoVersions:
+		return "no versions of " + i.terms[0].describe(false) + " exist"
+	case causeRoot:
+		return "root is required"
+	}
+
+	if i.isFailure() {
+		return "version solving failed"
+	}
+
+	if len(i.terms) == 1 {
+		if t := i.terms[0]; t.positive {
+			return t.describe(true) + " is forbidden"
+		}
+		return i.terms[0].describe(false) + " is required"
+	}
+
+	var pos, neg []string
+	for _, t := range i.terms {
+		if t.positive {
+			pos = append(pos, t.describe(true))
+		} else {
+			neg = append(neg, t.describe(false))
+		}
+	}
+
+	switch {
+	case len(pos) == 2 && len(neg) == 0:
+		return pos[0] + " is incompatible with " + pos[1]
+	case len(pos) == 1 && len(neg) > 0:
+		return pos[0] + " requires " + strings.Join(neg, " or ")
+	case len(pos) > 0 && len(neg) > 0:
+		return "if " + strings.Join(pos, " and ") + " then " + strings.Join(neg, " or ")
+	case len(pos) > 0:
+		return "one of " + strings.Join(pos, " or ") + " must be false"
+	}
+
+	return "one of " + strings.Join(neg, " or ") + " must be true"
+}
+
+// and renders this incompatibility and another as a conjunction, referring to
+// each by line number if one is given.
+func (i *incompatibility) and(other *incompatibility, line, otherLine int) string {
+	return withLine(i.String(), line) + " and " + withLine(other.String(), otherLine)
+}
+
+func withLine(s string, line int) string {
+	if line == 0 {
+		return s
+	}
+
+	return s + " (" + strconv.Itoa(line) + ")"
+}
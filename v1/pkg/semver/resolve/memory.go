@@ -0,0 +1,67 @@
+package resolve
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+// MemorySource is a Source holding packages in memory, intended for tests.
+//
+// The zero value is an empty source ready for use.
+type MemorySource struct {
+	packages map[string][]memoryVersion
+}
+
+type memoryVersion struct {
+	version semver.Version
+	deps    []Dependency
+}
+
+// Add adds a version of the named package with the given dependencies,
+// replacing any version of equal precedence already added.
+func (m *MemorySource) Add(pkg string, version semver.Version, deps ...Dependency) {
+	if m.packages == nil {
+		m.packages = make(map[string][]memoryVersion)
+	}
+
+	versions := m.packages[pkg]
+
+	for i := range versions {
+		if versions[i].version.Compare(&version) == 0 {
+			versions[i] = memoryVersion{version, deps}
+			return
+		}
+	}
+
+	m.packages[pkg] = append(versions, memoryVersion{version, deps})
+}
+
+// Versions implements Source.
+func (m *MemorySource) Versions(pkg string) ([]semver.Version, error) {
+	versions := m.packages[pkg]
+
+	out := make([]semver.Version, len(versions))
+	for i := range versions {
+		out[i] = versions[i].version
+	}
+
+	return out, nil
+}
+
+// Dependencies implements Source.  A version that was not added has no
+// dependencies.
+func (m *MemorySource) Dependencies(pkg string, version semver.Version) ([]Dependency, error) {
+	for _, v := range m.packages[pkg] {
+		if v.version.Compare(&version) == 0 {
+			return v.deps, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// MustDependency returns a dependency on the named package with the given
+// constraint string.  Panics if the constraint cannot be parsed.
+func MustDependency(pkg, c string) Dependency {
+	return Dependency{Package: pkg, Constraint: constraint.MustParseConstraint(c)}
+}
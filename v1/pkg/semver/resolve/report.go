@@ -0,0 +1,202 @@
+package resolve
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NoSolutionError is returned by Resolve when no selection of versions
+// satisfies the requirements.
+//
+// Its message is a derivation explaining why: each line follows from the
+// dependencies of particular package versions, the absence of versions, or
+// earlier lines, which are referred to by number.
+type NoSolutionError struct {
+	cause *incompatibility
+}
+
+func (n *NoSolutionError) Error() string {
+	return "resolve: no solution found:\n" + n.Derivation()
+}
+
+// Derivation returns the explanation of why no solution exists.
+func (n *NoSolutionError) Derivation() string {
+	r := reporter{
+		derivations: make(map[*incompatibility]int),
+		lineNumbers: make(map[*incompatibility]int),
+	}
+
+	return r.report(n.cause)
+}
+
+type reportLine struct {
+	message string
+	number  int
+}
+
+// reporter builds the derivation of a failure incompatibility, following the
+// error reporting algorithm described in the PubGrub documentation.
+type reporter struct {
+	// derivations counts the number of times each incompatibility is used in
+	// the derivation of the failure.
+	derivations map[*incompatibility]int
+
+	// lineNumbers holds the number of each line explaining an incompatibility
+	// that is referred to more than once.
+	lineNumbers map[*incompatibility]int
+
+	lines []reportLine
+}
+
+func (r *reporter) report(failure *incompatibility) string {
+	if failure.cause != causeConflict {
+		return "Because " + failure.String() + ", version solving failed."
+	}
+
+	r.countDerivations(failure)
+	r.visit(failure, failure, false)
+
+	width := 0
+	if n := len(r.lineNumbers); n > 0 {
+		width = len(strconv.Itoa(n)) + 3
+	}
+
+	out := make([]string, len(r.lines))
+	for i, l := range r.lines {
+		prefix := ""
+		if l.number > 0 {
+			prefix = "(" + strconv.Itoa(l.number) + ") "
+		}
+
+		if l.message != "" {
+			prefix += strings.Repeat(" ", width-len(prefix))
+		}
+
+		out[i] = prefix + l.message
+	}
+
+	return strings.Join(out, "\n")
+}
+
+func (r *reporter) countDerivations(inc *incompatibility) {
+	if _, ok := r.derivations[inc]; ok {
+		r.derivations[inc]++
+		return
+	}
+
+	r.derivations[inc] = 1
+
+	if inc.cause == causeConflict {
+		r.countDerivations(inc.conflict)
+		r.countDerivations(inc.other)
+	}
+}
+
+func (r *reporter) write(inc *incompatibility, message string, numbered bool) {
+	line := reportLine{message: message}
+
+	if numbered {
+		line.number = len(r.lineNumbers) + 1
+		r.lineNumbers[inc] = line.number
+	}
+
+	r.lines = append(r.lines, line)
+}
+
+func (r *reporter) visit(failure, inc *incompatibility, conclusion bool) {
+	numbered := conclusion || r.derivations[inc] > 1
+
+	conjunction := "And"
+	if conclusion || inc == failure {
+		conjunction = "So,"
+	}
+
+	conflict, other := inc.conflict, inc.other
+	conflictDerived, otherDerived := conflict.cause == causeConflict, other.cause == causeConflict
+
+	switch {
+	case conflictDerived && otherDerived:
+		conflictLine, otherLine := r.lineNumbers[conflict], r.lineNumbers[other]
+
+		switch {
+		case conflictLine > 0 && otherLine > 0:
+			r.write(inc, "Because "+conflict.and(other, conflictLine, otherLine)+", "+inc.String()+".", numbered)
+
+		case conflictLine > 0 || otherLine > 0:
+			with, without, line := conflict, other, conflictLine
+			if otherLine > 0 {
+				with, without, line = other, conflict, otherLine
+			}
+
+			r.visit(failure, without, false)
+			r.write(inc, conjunction+" because "+withLine(with.String(), line)+", "+inc.String()+".", numbered)
+
+		case isSingleLine(conflict) || isSingleLine(other):
+			first, second := other, conflict
+			if isSingleLine(other) {
+				first, second = conflict, other
+			}
+
+			r.visit(failure, first, false)
+			r.visit(failure, second, false)
+			r.write(inc, "Thus, "+inc.String()+".", numbered)
+
+		default:
+			r.visit(failure, conflict, true)
+			r.lines = append(r.lines, reportLine{})
+			r.visit(failure, other, false)
+			r.write(inc, conjunction+" because "+withLine(conflict.String(), r.lineNumbers[conflict])+", "+
+				inc.String()+".", numbered)
+		}
+
+	case conflictDerived || otherDerived:
+		derived, external := conflict, other
+		if otherDerived {
+			derived, external = other, conflict
+		}
+
+		if line := r.lineNumbers[derived]; line > 0 {
+			r.write(inc, "Because "+external.and(derived, 0, line)+", "+inc.String()+".", numbered)
+		} else if r.isCollapsible(derived) {
+			inner, innerExternal := derived.conflict, derived.other
+			if inner.cause != causeConflict {
+				inner, innerExternal = derived.other, derived.conflict
+			}
+
+			r.visit(failure, inner, false)
+			r.write(inc, conjunction+" because "+innerExternal.and(external, 0, 0)+", "+inc.String()+".", numbered)
+		} else {
+			r.visit(failure, derived, false)
+			r.write(inc, conjunction+" because "+external.String()+", "+inc.String()+".", numbered)
+		}
+
+	default:
+		r.write(inc, "Because "+conflict.and(other, 0, 0)+", "+inc.String()+".", numbered)
+	}
+}
+
+// isSingleLine returns whether the given derived incompatibility follows
+// directly from two external incompatibilities.
+func isSingleLine(inc *incompatibility) bool {
+	return inc.conflict.cause != causeConflict && inc.other.cause != causeConflict
+}
+
+// isCollapsible returns whether the derivation of the given incompatibility
+// may be merged into the line that uses it.
+func (r *reporter) isCollapsible(inc *incompatibility) bool {
+	if r.derivations[inc] > 1 || inc.cause != causeConflict {
+		return false
+	}
+
+	conflictDerived, otherDerived := inc.conflict.cause == causeConflict, inc.other.cause == causeConflict
+	if conflictDerived == otherDerived {
+		return false
+	}
+
+	complex := inc.conflict
+	if otherDerived {
+		complex = inc.other
+	}
+
+	return r.lineNumbers[complex] == 0
+}
@@ -0,0 +1,83 @@
+// Package resolve selects a consistent set of package versions satisfying a
+// set of requirements, using the PubGrub version solving algorithm.
+//
+// Package versions and their dependencies are read from a Source.  When no
+// consistent selection exists, Resolve returns a *NoSolutionError whose message
+// explains, step by step, why the requirements cannot be satisfied.
+//
+// Constraints are matched by version precedence alone, as under
+// constraint.PrereleaseInclude.  Where a constraint allows both, release
+// versions are preferred over prerelease versions.
+package resolve
+
+import (
+	"errors"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+// ErrInconsistent is returned by Resolve if the solver stops making progress,
+// which can only happen if the constraints it is given disagree with the
+// constraint set operations, such as a Constraint built directly from invalid
+// versions.
+var ErrInconsistent = errors.New("resolve: inconsistent partial solution")
+
+// Dependency is a requirement on the versions of a named package.
+type Dependency struct {
+	Package    string
+	Constraint *constraint.Constraint
+}
+
+// Source provides the available versions of packages and their dependencies.
+type Source interface {
+	// Versions returns the available versions of the named package, in any
+	// order.  An unknown package has no versions.  Of versions with equal
+	// precedence, only the first returned is considered.
+	Versions(pkg string) ([]semver.Version, error)
+
+	// Dependencies returns the dependencies of the given version of the named
+	// package.
+	Dependencies(pkg string, version semver.Version) ([]Dependency, error)
+}
+
+// SourceError wraps an error returned by a Source.
+type SourceError struct {
+	Package string
+	Err     error
+}
+
+func (s *SourceError) Error() string {
+	return "resolve: reading package " + s.Package + ": " + s.Err.Error()
+}
+
+func (s *SourceError) Unwrap() error {
+	return s.Err
+}
+
+// Solution maps package names to their selected versions.
+type Solution map[string]semver.Version
+
+// Resolve selects a version of every package required, directly or
+// transitively, by the given requirements, such that every dependency of each
+// selected version is satisfied.  The newest allowed version of each package is
+// preferred.
+//
+// Returns a *NoSolutionError if no such selection exists, a *SourceError if the
+// source fails, or ErrInconsistent if the solver cannot make progress.
+func Resolve(src Source, requirements []Dependency) (Solution, error) {
+	s := newSolver(src, requirements)
+
+	if err := s.run(); err != nil {
+		return nil, err
+	}
+
+	out := make(Solution, len(s.sol.decisions)-1)
+	for pkg, v := range s.sol.decisions {
+		if pkg != rootPackage {
+			out[pkg] = v
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,311 @@
+package resolve_test
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/resolve"
+)
+
+type pkg struct {
+	name    string
+	version string
+	deps    []string
+}
+
+func source(t *testing.T, pkgs ...pkg) *resolve.MemorySource {
+	src := new(resolve.MemorySource)
+
+	for _, p := range pkgs {
+		v, err := semver.ParseStrict(p.version)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		var deps []resolve.Dependency
+		for _, d := range p.deps {
+			parts := strings.SplitN(d, " ", 2)
+			deps = append(deps, resolve.MustDependency(parts[0], parts[1]))
+		}
+
+		src.Add(p.name, v, deps...)
+	}
+
+	return src
+}
+
+// buildSource is a Source which returns every version of its packages twice,
+// once with build metadata.
+type buildSource struct {
+	*resolve.MemorySource
+}
+
+func (b buildSource) Versions(pkg string) ([]semver.Version, error) {
+	versions, err := b.MemorySource.Versions(pkg)
+
+	for _, v := range versions {
+		v.Build = []string{"b"}
+		versions = append(versions, v)
+	}
+
+	return versions, err
+}
+
+func requirements(reqs ...string) []resolve.Dependency {
+	var out []resolve.Dependency
+	for _, r := range reqs {
+		parts := strings.SplitN(r, " ", 2)
+		out = append(out, resolve.MustDependency(parts[0], parts[1]))
+	}
+	return out
+}
+
+func render(sol resolve.Solution) string {
+	var parts []string
+	for name, v := range sol {
+		parts = append(parts, name+" "+v.String())
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
+}
+
+func TestResolve(t *testing.T) {
+	tests := map[string]struct {
+		pkgs   []pkg
+		reqs   []string
+		expect string
+	}{
+		"no conflicts": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", []string{"bar ^1.0.0"}},
+				{"bar", "1.0.0", nil},
+				{"bar", "2.0.0", nil},
+			},
+			reqs:   []string{"foo ^1.0.0"},
+			expect: "bar 1.0.0, foo 1.0.0",
+		},
+		"avoiding conflict during decision making": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", []string{"bar ^1.0.0"}},
+				{"foo", "1.1.0", []string{"bar ^2.0.0"}},
+				{"bar", "1.0.0", nil},
+				{"bar", "1.1.0", nil},
+				{"bar", "2.0.0", nil},
+			},
+			reqs:   []string{"foo ^1.0.0", "bar ^1.0.0"},
+			expect: "bar 1.1.0, foo 1.0.0",
+		},
+		"performing conflict resolution": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", nil},
+				{"foo", "2.0.0", []string{"bar ^1.0.0"}},
+				{"bar", "1.0.0", []string{"foo ^1.0.0"}},
+			},
+			reqs:   []string{"foo >=1.0.0"},
+			expect: "foo 1.0.0",
+		},
+		"conflict resolution with a partial satisfier": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", nil},
+				{"foo", "1.1.0", []string{"left ^1.0.0", "right ^1.0.0"}},
+				{"left", "1.0.0", []string{"shared >=1.0.0"}},
+				{"right", "1.0.0", []string{"shared <2.0.0"}},
+				{"shared", "1.0.0", []string{"target ^1.0.0"}},
+				{"shared", "2.0.0", nil},
+				{"target", "1.0.0", nil},
+				{"target", "2.0.0", nil},
+			},
+			reqs:   []string{"foo ^1.0.0", "target ^2.0.0"},
+			expect: "foo 1.0.0, target 2.0.0",
+		},
+		"prefers releases": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", nil},
+				{"foo", "1.1.0-rc.1", nil},
+			},
+			reqs:   []string{"foo >=1.0.0"},
+			expect: "foo 1.0.0",
+		},
+		"allows prereleases": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", nil},
+				{"foo", "2.0.0-rc.1", nil},
+			},
+			reqs:   []string{"foo >=2.0.0-0"},
+			expect: "foo 2.0.0-rc.1",
+		},
+		"no requirements": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sol, err := resolve.Resolve(source(t, test.pkgs...), requirements(test.reqs...))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if got := render(sol); got != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestResolve_NoSolution(t *testing.T) {
+	tests := map[string]struct {
+		pkgs   []pkg
+		reqs   []string
+		expect string
+	}{
+		"missing package": {
+			reqs: []string{"foo ^1.0.0"},
+			expect: "Because no versions of foo >=1.0.0 <2.0.0-0 exist and root depends on foo >=1.0.0 <2.0.0-0, " +
+				"version solving failed.",
+		},
+		"linear error reporting": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", []string{"bar ^2.0.0"}},
+				{"bar", "2.0.0", []string{"baz ^3.0.0"}},
+				{"baz", "1.0.0", nil},
+				{"baz", "3.0.0", nil},
+			},
+			reqs: []string{"foo ^1.0.0", "baz ^1.0.0"},
+			expect: "Because every version of foo depends on bar >=2.0.0 <3.0.0-0 and every version of bar depends on " +
+				"baz >=3.0.0 <4.0.0-0, every version of foo requires baz >=3.0.0 <4.0.0-0.\n" +
+				"So, because root depends on foo >=1.0.0 <2.0.0-0 and root depends on baz >=1.0.0 <2.0.0-0, " +
+				"version solving failed.",
+		},
+		"branching error reporting": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", []string{"a ^1.0.0", "b ^1.0.0"}},
+				{"foo", "1.1.0", []string{"x ^1.0.0", "y ^1.0.0"}},
+				{"a", "1.0.0", []string{"b ^2.0.0"}},
+				{"b", "1.0.0", nil},
+				{"b", "2.0.0", nil},
+				{"x", "1.0.0", []string{"y ^2.0.0"}},
+				{"y", "1.0.0", nil},
+				{"y", "2.0.0", nil},
+			},
+			reqs: []string{"foo ^1.0.0"},
+			expect: strings.Join([]string{
+				"    Because every version of a depends on b >=2.0.0 <3.0.0-0 and foo <1.1.0 depends on a >=1.0.0 <2.0.0-0, " +
+					"foo <1.1.0 requires b >=2.0.0 <3.0.0-0.",
+				"(1) So, because foo <1.1.0 depends on b >=1.0.0 <2.0.0-0, foo <1.1.0 is forbidden.",
+				"",
+				"    Because every version of x depends on y >=2.0.0 <3.0.0-0 and foo >1.0.0 depends on x >=1.0.0 <2.0.0-0, " +
+					"foo >1.0.0 requires y >=2.0.0 <3.0.0-0.",
+				"    And because foo >1.0.0 depends on y >=1.0.0 <2.0.0-0, foo >1.0.0 is forbidden.",
+				"    And because foo <1.1.0 is forbidden (1), every version of foo is forbidden.",
+				"    So, because root depends on foo >=1.0.0 <2.0.0-0, version solving failed.",
+			}, "\n"),
+		},
+		"adjacent exclusive bounds": {
+			pkgs: []pkg{
+				{"foo", "0.0.3", nil},
+				{"foo", "0.0.4", nil},
+				{"bar", "1.0.0", []string{"foo >0.0.3"}},
+			},
+			reqs: []string{"foo ^0.0.3", "bar *"},
+			expect: "Because every version of bar depends on foo >0.0.3 and root depends on foo 0.0.3, " +
+				"every version of bar is forbidden.\n" +
+				"So, because root depends on bar, version solving failed.",
+		},
+		"adjacent exclusive bounds across patch": {
+			pkgs: []pkg{
+				{"foo", "1.0.0", nil},
+				{"foo", "1.0.1", nil},
+				{"bar", "1.0.0", []string{"foo >1.0.0"}},
+			},
+			reqs: []string{"foo <1.0.1-0", "bar *"},
+			expect: "Because every version of bar depends on foo >1.0.0 and root depends on foo <=1.0.0, " +
+				"every version of bar is forbidden.\n" +
+				"So, because root depends on bar, version solving failed.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := resolve.Resolve(source(t, test.pkgs...), requirements(test.reqs...))
+
+			var nerr *resolve.NoSolutionError
+			if !errors.As(err, &nerr) {
+				t.Fatalf("Expected *NoSolutionError, got %v", err)
+			}
+
+			if got := nerr.Derivation(); got != test.expect {
+				t.Errorf("Expected:\n%s\ngot:\n%s", test.expect, got)
+			}
+		})
+	}
+}
+
+type failingSource struct {
+	resolve.MemorySource
+}
+
+var errUnavailable = errors.New("registry unavailable")
+
+func (f *failingSource) Versions(pkg string) ([]semver.Version, error) {
+	if pkg == "bar" {
+		return nil, errUnavailable
+	}
+	return f.MemorySource.Versions(pkg)
+}
+
+func TestResolve_SourceError(t *testing.T) {
+	src := &failingSource{*source(t, pkg{"foo", "1.0.0", []string{"bar ^1.0.0"}})}
+
+	_, err := resolve.Resolve(src, requirements("foo ^1.0.0"))
+
+	var serr *resolve.SourceError
+	if !errors.As(err, &serr) || serr.Package != "bar" {
+		t.Fatalf("Expected *SourceError for bar, got %v", err)
+	}
+
+	if !errors.Is(err, errUnavailable) {
+		t.Errorf("Expected %s, got %s", errUnavailable, err)
+	}
+}
+
+func TestMemorySource(t *testing.T) {
+	src := source(t,
+		pkg{"foo", "1.0.0", []string{"bar ^1.0.0"}},
+		pkg{"foo", "1.0.0+rebuild", []string{"bar ^2.0.0"}},
+		pkg{"foo", "2.0.0", nil},
+	)
+
+	versions, _ := src.Versions("foo")
+	if len(versions) != 2 {
+		t.Fatalf("Expected 2 versions, got %d", len(versions))
+	}
+
+	deps, _ := src.Dependencies("foo", semver.Version{Major: 1})
+	if len(deps) != 1 || deps[0].Constraint.String() != ">=2.0.0 <3.0.0-0" {
+		t.Errorf("Expected replaced dependency, got %+v", deps)
+	}
+
+	if versions, _ := src.Versions("missing"); len(versions) != 0 {
+		t.Errorf("Expected no versions, got %d", len(versions))
+	}
+}
+
+func TestResolve_equalPrecedence(t *testing.T) {
+	src := buildSource{source(t, pkg{"foo", "1.0.0", []string{"bar ^2"}})}
+
+	sol, err := resolve.Resolve(src, requirements("foo *"))
+
+	var nerr *resolve.NoSolutionError
+	if !errors.As(err, &nerr) {
+		t.Fatalf("Expected *NoSolutionError, got %v, %v", sol, err)
+	}
+
+	expect := "Because every version of foo depends on bar >=2.0.0 <3.0.0-0 and no versions of bar >=2.0.0 <3.0.0-0 " +
+		"exist, every version of foo is forbidden.\n" +
+		"So, because root depends on foo, version solving failed."
+	if got := nerr.Derivation(); got != expect {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expect, got)
+	}
+}
@@ -0,0 +1,156 @@
+package resolve
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+type relation uint8
+
+const (
+	relSatisfied relation = iota
+	relContradicted
+	relInconclusive
+	relAlmostSatisfied
+)
+
+// assignment is a term added to the partial solution, either as a decision
+// to select a version or as a derivation from an incompatibility.
+type assignment struct {
+	term
+
+	// level is the number of decisions made when the assignment was added.
+	level int
+
+	// index is the position of the assignment in the partial solution.
+	index int
+
+	// cause is the incompatibility the assignment was derived from, or nil for
+	// decisions.
+	cause *incompatibility
+}
+
+// solution is the partial solution built up while solving.
+type solution struct {
+	assignments []assignment
+	decisions   map[string]semver.Version
+
+	// terms holds the intersection of the assigned terms for each package.
+	terms map[string]term
+}
+
+func newSolution() *solution {
+	return &solution{decisions: make(map[string]semver.Version), terms: make(map[string]term)}
+}
+
+func (s *solution) level() int {
+	return len(s.decisions)
+}
+
+func (s *solution) decide(pkg string, v semver.Version) {
+	s.decisions[pkg] = v
+	s.assign(assignment{term: term{pkg, exactly(v), true}, level: s.level()})
+}
+
+func (s *solution) derive(t term, cause *incompatibility) {
+	s.assign(assignment{term: t, level: s.level(), cause: cause})
+}
+
+func (s *solution) assign(a assignment) {
+	a.index = len(s.assignments)
+	s.assignments = append(s.assignments, a)
+
+	if acc, ok := s.terms[a.pkg]; ok {
+		s.terms[a.pkg] = acc.intersect(a.term)
+	} else {
+		s.terms[a.pkg] = a.term
+	}
+}
+
+// backtrack removes every assignment made after the given decision level.
+func (s *solution) backtrack(level int) {
+	old := s.assignments
+
+	s.assignments = nil
+	s.decisions = make(map[string]semver.Version)
+	s.terms = make(map[string]term)
+
+	for _, a := range old {
+		if a.level > level {
+			break
+		}
+
+		if a.cause == nil {
+			s.decisions[a.pkg] = a.set.Ranges[0][0].Version
+		}
+
+		s.assign(a)
+	}
+}
+
+// relation returns how the partial solution relates to the given term.
+func (s *solution) relation(t term) relation {
+	acc, ok := s.terms[t.pkg]
+
+	switch {
+	case !ok:
+		return relInconclusive
+	case acc.satisfies(t):
+		return relSatisfied
+	case acc.contradicts(t):
+		return relContradicted
+	}
+
+	return relInconclusive
+}
+
+func (s *solution) satisfies(t term) bool {
+	return s.relation(t) == relSatisfied
+}
+
+// satisfier returns the earliest assignment after which the partial solution
+// satisfies the given term.  Returns ErrInconsistent if the partial solution
+// does not satisfy the term.
+func (s *solution) satisfier(t term) (assignment, error) {
+	var acc term
+	found := false
+
+	for _, a := range s.assignments {
+		if a.pkg != t.pkg {
+			continue
+		}
+
+		if found {
+			acc = acc.intersect(a.term)
+		} else {
+			acc, found = a.term, true
+		}
+
+		if acc.satisfies(t) {
+			return a, nil
+		}
+	}
+
+	return assignment{}, ErrInconsistent
+}
+
+// undecided returns the packages with a positive term in the partial solution
+// but no selected version, in the order they were first assigned.
+func (s *solution) undecided() []term {
+	var out []term
+	seen := make(map[string]bool)
+
+	for _, a := range s.assignments {
+		if seen[a.pkg] {
+			continue
+		}
+		seen[a.pkg] = true
+
+		if _, ok := s.decisions[a.pkg]; !ok {
+			if t := s.terms[a.pkg]; t.positive {
+				out = append(out, t)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,378 @@
+package resolve
+
+import (
+	"sort"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+type solver struct {
+	src  Source
+	root []Dependency
+	sol  *solution
+
+	// incompatibilities indexes every known incompatibility by each package it
+	// refers to.
+	incompatibilities map[string][]*incompatibility
+
+	versions map[string][]semver.Version
+}
+
+func newSolver(src Source, root []Dependency) *solver {
+	return &solver{
+		src:               src,
+		root:              root,
+		sol:               newSolution(),
+		incompatibilities: make(map[string][]*incompatibility),
+		versions:          map[string][]semver.Version{rootPackage: {rootVersion}},
+	}
+}
+
+func (s *solver) run() error {
+	s.addIncompatibility(newIncompatibility([]term{{rootPackage, exactly(rootVersion), false}}, causeRoot, nil, nil))
+
+	next := rootPackage
+
+	for {
+		if err := s.propagate(next); err != nil {
+			return err
+		}
+
+		pkg, done, err := s.decide()
+		if err != nil || done {
+			return err
+		}
+
+		next = pkg
+	}
+}
+
+func (s *solver) addIncompatibility(inc *incompatibility) {
+	for _, t := range inc.terms {
+		s.incompatibilities[t.pkg] = append(s.incompatibilities[t.pkg], inc)
+	}
+}
+
+// propagate derives every assignment implied by the incompatibilities of the
+// given package and, transitively, of the packages those assignments refer to.
+func (s *solver) propagate(pkg string) error {
+	changed := []string{pkg}
+
+	for len(changed) > 0 {
+		pkg, changed = changed[len(changed)-1], changed[:len(changed)-1]
+
+		incs := s.incompatibilities[pkg]
+
+		for i := len(incs) - 1; i >= 0; i-- {
+			rel, t := s.relation(incs[i])
+
+			if rel == relSatisfied {
+				cause, err := s.resolveConflict(incs[i])
+				if err != nil {
+					return err
+				}
+
+				if rel, t = s.relation(cause); rel != relAlmostSatisfied {
+					return ErrInconsistent
+				}
+
+				if err := s.derive(t, cause); err != nil {
+					return err
+				}
+
+				changed = append(changed[:0], t.pkg)
+				break
+			}
+
+			if rel == relAlmostSatisfied {
+				if err := s.derive(t, incs[i]); err != nil {
+					return err
+				}
+
+				changed = appendUnique(changed, t.pkg)
+			}
+		}
+	}
+
+	return nil
+}
+
+// derive adds the inverse of the one term of the given almost satisfied
+// incompatibility not yet satisfied by the partial solution.  Returns
+// ErrInconsistent if the term is not then contradicted, as propagation would
+// otherwise derive it again without end.
+func (s *solver) derive(t term, cause *incompatibility) error {
+	s.sol.derive(t.inverse(), cause)
+
+	if s.sol.relation(t) != relContradicted {
+		return ErrInconsistent
+	}
+
+	return nil
+}
+
+// relation returns how the partial solution relates to the given
+// incompatibility, and if it is almost satisfied, the one term not satisfied.
+func (s *solver) relation(inc *incompatibility) (relation, term) {
+	var unsatisfied term
+	found := false
+
+	for _, t := range inc.terms {
+		switch s.sol.relation(t) {
+		case relContradicted:
+			return relContradicted, t
+		case relInconclusive:
+			if found {
+				return relInconclusive, t
+			}
+			unsatisfied, found = t, true
+		}
+	}
+
+	if !found {
+		return relSatisfied, unsatisfied
+	}
+
+	return relAlmostSatisfied, unsatisfied
+}
+
+// resolveConflict derives, from an incompatibility satisfied by the partial
+// solution, an incompatibility that will be almost satisfied once the partial
+// solution is backtracked to an earlier decision level.
+func (s *solver) resolveConflict(inc *incompatibility) (*incompatibility, error) {
+	learned := false
+
+	for !inc.isFailure() {
+		var recentTerm, difference term
+		var recent assignment
+		hasRecent, hasDifference := false, false
+		previousLevel := 1
+
+		for _, t := range inc.terms {
+			satisfier, err := s.sol.satisfier(t)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case !hasRecent:
+				recent, recentTerm, hasRecent = satisfier, t, true
+			case recent.index < satisfier.index:
+				previousLevel = max(previousLevel, recent.level)
+				recent, recentTerm = satisfier, t
+			default:
+				previousLevel = max(previousLevel, satisfier.level)
+				continue
+			}
+
+			// If the satisfier alone does not satisfy the term, the earlier
+			// assignments excluding the difference are also part of the conflict.
+			difference = recent.difference(recentTerm)
+			hasDifference = !difference.isEmpty()
+
+			if hasDifference {
+				prior, err := s.sol.satisfier(difference.inverse())
+				if err != nil {
+					return nil, err
+				}
+
+				previousLevel = max(previousLevel, prior.level)
+			}
+		}
+
+		if previousLevel < recent.level || recent.cause == nil {
+			s.sol.backtrack(previousLevel)
+
+			if learned {
+				s.addIncompatibility(inc)
+			}
+
+			return inc, nil
+		}
+
+		var terms []term
+
+		for _, t := range inc.terms {
+			if t.pkg != recentTerm.pkg {
+				terms = append(terms, t)
+			}
+		}
+
+		for _, t := range recent.cause.terms {
+			if t.pkg != recent.pkg {
+				terms = append(terms, t)
+			}
+		}
+
+		if hasDifference {
+			terms = append(terms, difference.inverse())
+		}
+
+		inc = newIncompatibility(terms, causeConflict, inc, recent.cause)
+		learned = true
+	}
+
+	return nil, &NoSolutionError{cause: inc}
+}
+
+// decide selects a version of the undecided package with the fewest allowed
+// versions.  Returns true once every required package has a selected version.
+func (s *solver) decide() (string, bool, error) {
+	undecided := s.sol.undecided()
+	if len(undecided) == 0 {
+		return "", true, nil
+	}
+
+	var pick term
+	var matching []semver.Version
+
+	for i, t := range undecided {
+		versions, err := s.allowed(t)
+		if err != nil {
+			return "", false, err
+		}
+
+		if i == 0 || len(versions) < len(matching) {
+			pick, matching = t, versions
+		}
+	}
+
+	if len(matching) == 0 {
+		s.addIncompatibility(newIncompatibility([]term{pick}, causeNoVersions, nil, nil))
+		return pick.pkg, false, nil
+	}
+
+	version := preferred(matching)
+
+	deps, err := s.dependencies(pick.pkg, version)
+	if err != nil {
+		return "", false, err
+	}
+
+	depender := term{pick.pkg, s.between(pick.pkg, version), true}
+	conflict := false
+
+	for _, d := range deps {
+		dep := term{d.Package, d.Constraint, false}
+		s.addIncompatibility(newIncompatibility([]term{depender, dep}, causeDependency, nil, nil))
+
+		conflict = conflict || s.sol.satisfies(dep)
+	}
+
+	if !conflict {
+		s.sol.decide(pick.pkg, version)
+	}
+
+	return pick.pkg, false, nil
+}
+
+// allowed returns the available versions of the term's package within the
+// term's set, newest first.
+func (s *solver) allowed(t term) ([]semver.Version, error) {
+	versions, ok := s.versions[t.pkg]
+
+	if !ok {
+		var err error
+
+		versions, err = s.src.Versions(t.pkg)
+		if err != nil {
+			return nil, &SourceError{Package: t.pkg, Err: err}
+		}
+
+		versions = append([]semver.Version(nil), versions...)
+		sort.SliceStable(versions, func(i, j int) bool {
+			return versions[i].Compare(&versions[j]) > 0
+		})
+
+		// Versions of equal precedence, differing only in build metadata, are
+		// indistinguishable to constraints, so only the first is kept.
+		unique := versions[:0]
+		for i := range versions {
+			if len(unique) == 0 || unique[len(unique)-1].Compare(&versions[i]) != 0 {
+				unique = append(unique, versions[i])
+			}
+		}
+
+		versions = unique
+		s.versions[t.pkg] = versions
+	}
+
+	var out []semver.Version
+
+	for i := range versions {
+		if t.set.CheckWith(&versions[i], constraint.PrereleaseInclude) {
+			out = append(out, versions[i])
+		}
+	}
+
+	return out, nil
+}
+
+// between returns the set of versions lying strictly between the available
+// versions of a package neighbouring the given version.  As the given version
+// is the only available version in that set, the dependencies of the given
+// version apply to the whole set, which makes for shorter explanations.
+func (s *solver) between(pkg string, v semver.Version) *constraint.Constraint {
+	versions := s.versions[pkg]
+	out := constraint.Interval{Lower: constraint.Bound{Unbounded: true}, Upper: constraint.Bound{Unbounded: true}}
+
+	for i := range versions {
+		if versions[i].Compare(&v) != 0 {
+			continue
+		}
+
+		if i > 0 {
+			out.Upper = constraint.Bound{Version: versions[i-1]}
+		}
+
+		if i+1 < len(versions) {
+			out.Lower = constraint.Bound{Version: versions[i+1]}
+		}
+	}
+
+	return &constraint.Constraint{Ranges: []constraint.Range{out.Range()}}
+}
+
+func (s *solver) dependencies(pkg string, v semver.Version) ([]Dependency, error) {
+	if pkg == rootPackage {
+		return s.root, nil
+	}
+
+	deps, err := s.src.Dependencies(pkg, v)
+	if err != nil {
+		return nil, &SourceError{Package: pkg, Err: err}
+	}
+
+	return deps, nil
+}
+
+// preferred returns the newest release in the given versions, sorted newest
+// first, or the newest prerelease if there are no releases.
+func preferred(versions []semver.Version) semver.Version {
+	for _, v := range versions {
+		if len(v.Prerelease) == 0 {
+			return v
+		}
+	}
+
+	return versions[0]
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+
+	return append(list, s)
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
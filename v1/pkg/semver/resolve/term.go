@@ -0,0 +1,102 @@
+package resolve
+
+import (
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+	"github.com/foxcapades/gVersion/v1/pkg/semver/constraint"
+)
+
+// rootPackage is the name of the virtual package holding the requirements
+// given to Resolve.
+const rootPackage = ""
+
+var rootVersion = semver.Version{}
+
+// term is a statement about the selected version of a package.  A positive
+// term requires a version in the set to be selected; a negative term requires
+// that no version in the set is selected, which is also satisfied by selecting
+// no version of the package at all.
+type term struct {
+	pkg      string
+	set      *constraint.Constraint
+	positive bool
+}
+
+func exactly(v semver.Version) *constraint.Constraint {
+	return &constraint.Constraint{Ranges: []constraint.Range{{{Operator: constraint.OpEqual, Version: v}}}}
+}
+
+func (t term) inverse() term {
+	return term{t.pkg, t.set, !t.positive}
+}
+
+// intersect returns a term satisfied only by states satisfying both terms,
+// which must refer to the same package.
+func (t term) intersect(o term) term {
+	switch {
+	case t.positive && o.positive:
+		return term{t.pkg, t.set.Intersect(o.set), true}
+	case t.positive:
+		return term{t.pkg, t.set.Intersect(o.set.Complement()), true}
+	case o.positive:
+		return term{t.pkg, o.set.Intersect(t.set.Complement()), true}
+	}
+
+	return term{t.pkg, t.set.Union(o.set), false}
+}
+
+// difference returns a term satisfied by states satisfying t but not o.
+func (t term) difference(o term) term {
+	return t.intersect(o.inverse())
+}
+
+// isEmpty returns whether no state satisfies the term.
+func (t term) isEmpty() bool {
+	return t.positive && t.set.IsEmpty()
+}
+
+// satisfies returns whether every state satisfying t also satisfies o.
+func (t term) satisfies(o term) bool {
+	switch {
+	case t.positive && o.positive:
+		return t.set.IsSubsetOf(o.set)
+	case t.positive:
+		return t.set.Intersect(o.set).IsEmpty()
+	case o.positive:
+		return false
+	}
+
+	return o.set.IsSubsetOf(t.set)
+}
+
+// contradicts returns whether no state satisfies both t and o.
+func (t term) contradicts(o term) bool {
+	switch {
+	case t.positive && o.positive:
+		return t.set.Intersect(o.set).IsEmpty()
+	case t.positive:
+		return t.set.IsSubsetOf(o.set)
+	case o.positive:
+		return o.set.IsSubsetOf(t.set)
+	}
+
+	return false
+}
+
+// describe renders the package and versions of the term, ignoring whether it
+// is positive.
+func (t term) describe(every bool) string {
+	if t.pkg == rootPackage {
+		return "root"
+	}
+
+	set := t.set.Canonical()
+
+	switch {
+	case set != "*":
+		return t.pkg + " " + set
+	case every:
+		return "every version of " + t.pkg
+	}
+
+	return t.pkg
+}
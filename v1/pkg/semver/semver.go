@@ -6,9 +6,9 @@ import (
 
 // Version holds the components of a SemVer version number.
 type Version struct {
-	Major      uint8
-	Minor      uint8
-	Patch      uint8
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
 	Build      []string
 	Prerelease []string
 }
@@ -16,23 +16,26 @@ type Version struct {
 // Equivalent returns whether this Version is the same as the given version
 // when comparing the Major, Minor, Patch, and Prerelease values.
 func (v *Version) Equivalent(other *Version) bool {
-	switch false {
-	case v.Major == other.Major:
-		return false
-	case v.Minor == other.Minor:
-		return false
-	case v.Patch == other.Patch:
+	return v.Compare(other) == 0
+}
+
+// Equal returns whether this Version is the same as the given version comparing
+// all fields, including prerelease or build tags.
+func (v *Version) Equal(other *Version) bool {
+	if !v.Equivalent(other) {
 		return false
 	}
 
-	tln := len(v.Prerelease)
+	return equalIdentifiers(v.Prerelease, other.Prerelease) && equalIdentifiers(v.Build, other.Build)
+}
 
-	if tln != len(other.Prerelease) {
+func equalIdentifiers(a, b []string) bool {
+	if len(a) != len(b) {
 		return false
 	}
 
-	for i := 0; i < tln; i++ {
-		if v.Prerelease[i] != other.Prerelease[i] {
+	for i := range a {
+		if a[i] != b[i] {
 			return false
 		}
 	}
@@ -40,48 +43,35 @@ func (v *Version) Equivalent(other *Version) bool {
 	return true
 }
 
-// Equal returns whether this Version is the same as the given version comparing
-// all fields, including prerelease or build tags.
-func (v *Version) Equal(other *Version) bool {
-	if !v.Equivalent(other) {
-		return false
-	}
-
-	if len(v.Build) != len(other.Build) {
-		return false
+// Compare returns an integer comparing the precedence of this Version to the
+// given version following the rules laid out in section 11 of the SemVer 2.0.0
+// specification.
+//
+// The result will be -1 if v precedes other, 0 if they have the same
+// precedence, and +1 if v follows other.  Build metadata is ignored.
+func (v *Version) Compare(other *Version) int {
+	switch true {
+	case v.Major != other.Major:
+		return compareU64(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return compareU64(v.Minor, other.Minor)
+	case v.Patch != other.Patch:
+		return compareU64(v.Patch, other.Patch)
 	}
 
-	return true
+	return comparePrerelease(v.Prerelease, other.Prerelease)
 }
 
 // IsAfter returns whether the current Version is a later version than the given
 // value.
 func (v *Version) IsAfter(other *Version) bool {
-	switch true {
-	case v.Major > other.Major:
-		return true
-	case v.Minor > other.Minor:
-		return true
-	case v.Patch > other.Patch:
-		return true
-	}
-
-	return len(v.Prerelease) == 0 && len(other.Prerelease) > 0
+	return v.Compare(other) > 0
 }
 
-// IsAfter returns whether the current Version is an earlier version number than
-// the given value.
+// IsBefore returns whether the current Version is an earlier version number
+// than the given value.
 func (v *Version) IsBefore(other *Version) bool {
-	switch true {
-	case v.Major < other.Major:
-		return true
-	case v.Minor < other.Minor:
-		return true
-	case v.Patch < other.Patch:
-		return true
-	}
-
-	return len(other.Prerelease) == 0 && len(v.Prerelease) > 0
+	return v.Compare(other) < 0
 }
 
 // VString prints the string form of this Version with a leading 'v' character.
@@ -101,16 +91,52 @@ func (v *Version) String() string {
 	return string(out)
 }
 
-func (v *Version) outSize() (size uint8) {
-	size += bytify.Uint8StringSize(v.Major) + bytify.Uint8StringSize(v.Minor)
-	size += bytify.Uint8StringSize(v.Patch) + 2
+// AppendString appends the string form of this Version to the given slice and
+// returns the result.  No allocation is made if dst has enough spare capacity.
+func (v *Version) AppendString(dst []byte) []byte {
+	pos := len(dst)
+	dst = grow(dst, v.outSize())
+	v.stringFill(dst, pos)
+
+	return dst
+}
+
+// AppendVString appends the string form of this Version with a leading 'v'
+// character to the given slice and returns the result.  No allocation is made
+// if dst has enough spare capacity.
+func (v *Version) AppendVString(dst []byte) []byte {
+	pos := len(dst)
+	dst = grow(dst, v.outSize()+1)
+	dst[pos] = leader
+	v.stringFill(dst, pos+1)
+
+	return dst
+}
+
+// grow extends the length of the given slice by n bytes, reallocating only if
+// its capacity is insufficient.
+func grow(buf []byte, n int) []byte {
+	if cap(buf)-len(buf) >= n {
+		return buf[:len(buf)+n]
+	}
+
+	out := make([]byte, len(buf)+n, 2*len(buf)+n)
+	copy(out, buf)
+
+	return out
+}
+
+func (v *Version) outSize() (size int) {
+	size += int(bytify.Uint64StringSize(v.Major))
+	size += int(bytify.Uint64StringSize(v.Minor))
+	size += int(bytify.Uint64StringSize(v.Patch)) + 2
 
-	if ln := uint8(len(v.Prerelease)); ln > 0 {
+	if ln := len(v.Prerelease); ln > 0 {
 		// Add one for the hyphen separator
 		size++
 
-		for i := uint8(0); i < ln; {
-			size += uint8(len(v.Prerelease[i]))
+		for i := 0; i < ln; {
+			size += len(v.Prerelease[i])
 
 			if i++; i < ln {
 				// Add one for the period separator.
@@ -119,12 +145,12 @@ func (v *Version) outSize() (size uint8) {
 		}
 	}
 
-	if ln := uint8(len(v.Build)); ln > 0 {
+	if ln := len(v.Build); ln > 0 {
 		// Add one for the plus separator
 		size++
 
-		for i := uint8(0); i < ln; {
-			size += uint8(len(v.Build[i]))
+		for i := 0; i < ln; {
+			size += len(v.Build[i])
 
 			if i++; i < ln {
 				// Add one for the period separator.
@@ -136,23 +162,23 @@ func (v *Version) outSize() (size uint8) {
 	return
 }
 
-func (v *Version) stringFill(out []byte, pos uint8) {
-	pos += bytify.Uint8ToBytes(v.Major, out[pos:])
+func (v *Version) stringFill(out []byte, pos int) {
+	pos += int(bytify.Uint64ToBytes(v.Major, out[pos:]))
 	out[pos] = segDivider
 	pos++
 
-	pos += bytify.Uint8ToBytes(v.Minor, out[pos:])
+	pos += int(bytify.Uint64ToBytes(v.Minor, out[pos:]))
 	out[pos] = segDivider
 	pos++
 
-	pos += bytify.Uint8ToBytes(v.Patch, out[pos:])
+	pos += int(bytify.Uint64ToBytes(v.Patch, out[pos:]))
 
-	if ln := uint8(len(v.Prerelease)); ln > 0 {
+	if ln := len(v.Prerelease); ln > 0 {
 		out[pos] = preDivider
 		pos++
-		for i := uint8(0); i < ln; {
+		for i := 0; i < ln; {
 			copy(out[pos:], v.Prerelease[i])
-			pos += uint8(len(v.Prerelease[i]))
+			pos += len(v.Prerelease[i])
 
 			if i++; i < ln {
 				out[pos] = segDivider
@@ -161,12 +187,12 @@ func (v *Version) stringFill(out []byte, pos uint8) {
 		}
 	}
 
-	if ln := uint8(len(v.Build)); ln > 0 {
+	if ln := len(v.Build); ln > 0 {
 		out[pos] = buildDivider
 		pos++
-		for i := uint8(0); i < ln; {
+		for i := 0; i < ln; {
 			copy(out[pos:], v.Build[i])
-			pos += uint8(len(v.Build[i]))
+			pos += len(v.Build[i])
 
 			if i++; i < ln {
 				out[pos] = segDivider
@@ -17,6 +17,8 @@ func TestVersion_String(t *testing.T) {
 		{"1.0.1", semver.Version{Major: 1, Patch: 1}},
 		{"1.1.0", semver.Version{Major: 1, Minor: 1}},
 		{"1.1.1", semver.Version{Major: 1, Minor: 1, Patch: 1}},
+		{"1.300.20240115", semver.Version{Major: 1, Minor: 300, Patch: 20240115}},
+		{"18446744073709551615.0.0-rc.1", semver.Version{Major: 18446744073709551615, Prerelease: []string{"rc", "1"}}},
 		{"0.0.0-alpha", semver.Version{Prerelease: []string{"alpha"}}},
 		{"0.0.0-alpha.v1", semver.Version{Prerelease: []string{"alpha", "v1"}}},
 		{"0.0.0+2020-09-18", semver.Version{Build: []string{"2020-09-18"}}},
@@ -46,6 +48,8 @@ func TestVersion_VString(t *testing.T) {
 		{"v1.0.1", semver.Version{Major: 1, Patch: 1}},
 		{"v1.1.0", semver.Version{Major: 1, Minor: 1}},
 		{"v1.1.1", semver.Version{Major: 1, Minor: 1, Patch: 1}},
+		{"v1.300.20240115", semver.Version{Major: 1, Minor: 300, Patch: 20240115}},
+		{"v18446744073709551615.0.0-rc.1", semver.Version{Major: 18446744073709551615, Prerelease: []string{"rc", "1"}}},
 		{"v0.0.0-alpha", semver.Version{Prerelease: []string{"alpha"}}},
 		{"v0.0.0-alpha.v1", semver.Version{Prerelease: []string{"alpha", "v1"}}},
 		{"v0.0.0+2020-09-18", semver.Version{Build: []string{"2020-09-18"}}},
@@ -64,6 +68,37 @@ func TestVersion_VString(t *testing.T) {
 	}
 }
 
+func TestVersion_AppendString(t *testing.T) {
+	ver := semver.Version{Major: 1, Minor: 20, Patch: 300, Prerelease: []string{"rc", "1"}, Build: []string{"b5"}}
+
+	if out := ver.AppendString([]byte("ver=")); string(out) != "ver=1.20.300-rc.1+b5" {
+		t.Errorf("Expected ver=1.20.300-rc.1+b5, got %s", out)
+	}
+
+	if out := ver.AppendVString([]byte("ver=")); string(out) != "ver=v1.20.300-rc.1+b5" {
+		t.Errorf("Expected ver=v1.20.300-rc.1+b5, got %s", out)
+	}
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = ver.AppendVString(ver.AppendString(buf[:0]))
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %f", allocs)
+	}
+}
+
+func BenchmarkVersion_AppendString(b *testing.B) {
+	ver := semver.Version{Major: 1, Minor: 20, Patch: 300, Prerelease: []string{"rc", "1"}, Build: []string{"b5"}}
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = ver.AppendString(buf[:0])
+	}
+}
+
 func TestVersion_Equivalent(t *testing.T) {
 	tests := []struct {
 		name string
@@ -171,6 +206,24 @@ func TestVersion_Equal(t *testing.T) {
 			b: semver.Version{Major: 1, Minor: 10, Patch: 2},
 			se: false,
 		},
+		{
+			name: "different build tags",
+			a: semver.Version{Major: 1, Build: []string{"a"}},
+			b: semver.Version{Major: 1, Build: []string{"b"}},
+			se: false,
+		},
+		{
+			name: "equal build tags",
+			a: semver.Version{Major: 1, Prerelease: []string{"rc"}, Build: []string{"a", "1"}},
+			b: semver.Version{Major: 1, Prerelease: []string{"rc"}, Build: []string{"a", "1"}},
+			se: true,
+		},
+		{
+			name: "prerelease tags differing in leading zeros",
+			a: semver.Version{Major: 1, Prerelease: []string{"01"}},
+			b: semver.Version{Major: 1, Prerelease: []string{"1"}},
+			se: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -248,6 +301,24 @@ func TestVersion_IsAfter(t *testing.T) {
 			b: semver.Version{Major: 1, Minor: 10, Patch: 2},
 			se: false,
 		},
+		{
+			name: "lower minor higher major",
+			a: semver.Version{Major: 2, Minor: 0, Patch: 0},
+			b: semver.Version{Major: 1, Minor: 5, Patch: 0},
+			se: true,
+		},
+		{
+			name: "higher minor lower major",
+			a: semver.Version{Major: 1, Minor: 5, Patch: 0},
+			b: semver.Version{Major: 2, Minor: 0, Patch: 0},
+			se: false,
+		},
+		{
+			name: "different prerelease",
+			a: semver.Version{Major: 1, Minor: 10, Patch: 2, Prerelease: []string{"beta", "11"}},
+			b: semver.Version{Major: 1, Minor: 10, Patch: 2, Prerelease: []string{"beta", "2"}},
+			se: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -326,6 +397,24 @@ func TestVersion_IsBefore(t *testing.T) {
 			b: semver.Version{Major: 1, Minor: 10, Patch: 2},
 			se: false,
 		},
+		{
+			name: "lower minor higher major",
+			a: semver.Version{Major: 2, Minor: 0, Patch: 0},
+			b: semver.Version{Major: 1, Minor: 5, Patch: 0},
+			se: false,
+		},
+		{
+			name: "higher minor lower major",
+			a: semver.Version{Major: 1, Minor: 5, Patch: 0},
+			b: semver.Version{Major: 2, Minor: 0, Patch: 0},
+			se: true,
+		},
+		{
+			name: "different prerelease",
+			a: semver.Version{Major: 1, Minor: 10, Patch: 2, Prerelease: []string{"beta", "11"}},
+			b: semver.Version{Major: 1, Minor: 10, Patch: 2, Prerelease: []string{"beta", "2"}},
+			se: false,
+		},
 	}
 
 	for _, test := range tests {
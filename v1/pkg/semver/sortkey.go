@@ -0,0 +1,316 @@
+package semver
+
+// ErrInvalidSortKey is returned when decoding a malformed binary sort key.
+var ErrInvalidSortKey error = sentinel("invalid semantic version sort key")
+
+// Sort key layout:
+//
+//	major, minor, patch   Each encoded as a single length byte followed by the
+//	                      minimal big-endian bytes of the value, so longer
+//	                      (larger) values sort later.
+//
+//	prerelease            For a release version, the single byte keyRelease.
+//	                      Otherwise, each identifier in order followed by the
+//	                      byte keyEnd.
+//
+//	build                 Each identifier in order followed by the byte keyEnd.
+//
+//	zeros                 For each numeric prerelease or build identifier in
+//	                      order, the leading zeros trimmed from the identifier
+//	                      followed by the byte keyEnd.
+//
+// Identifiers are encoded as a tag byte followed by the identifier's content.
+// Numeric identifiers (keyNumeric) sort below alphanumeric identifiers
+// (keyAlpha) and are encoded as the length-prefixed digits of the value with
+// leading zeros removed.  Alphanumeric identifiers are encoded as their raw
+// bytes, with 0x00 escaped as 0x00 0xFF, followed by the terminator 0x00 0x01.
+//
+// Trimmed zeros are stored at the end of the key so that identifiers such as
+// "1" and "01", which have the same precedence, do not affect the ordering of
+// any identifiers that follow them.
+const (
+	keyEnd     byte = 0x00
+	keyNumeric byte = 0x01
+	keyAlpha   byte = 0x02
+	keyRelease byte = 0x03
+
+	keyEscape   byte = 0xFF
+	keyTerm     byte = 0x01
+	keyLongSize byte = 0xFF
+)
+
+// AppendSortKey appends an order-preserving binary encoding of this Version to
+// the given slice and returns the result.
+//
+// Comparing the keys of 2 versions bytewise, as with bytes.Compare, gives the
+// same result as comparing the versions with Compare, with versions of equal
+// precedence ordered by their build metadata.  This allows versions to be used
+// as keys in ordered key-value stores, where a range such as "1.x" may be
+// scanned from the key of 1.0.0-0 (inclusive) to the key of 2.0.0-0
+// (exclusive).
+func (v *Version) AppendSortKey(dst []byte) []byte {
+	return appendSortKey(dst, v)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, returning the same value
+// as AppendSortKey.
+func (v Version) MarshalBinary() ([]byte, error) {
+	return appendSortKey(nil, &v), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding a key
+// produced by AppendSortKey or MarshalBinary.
+func (v *Version) UnmarshalBinary(data []byte) (err error) {
+	*v, err = parseSortKey(data)
+	return
+}
+
+func appendSortKey(dst []byte, v *Version) []byte {
+	dst = appendKeyUint(dst, v.Major)
+	dst = appendKeyUint(dst, v.Minor)
+	dst = appendKeyUint(dst, v.Patch)
+
+	if len(v.Prerelease) == 0 {
+		dst = append(dst, keyRelease)
+	} else {
+		dst = appendKeyIdentifiers(dst, v.Prerelease)
+	}
+
+	dst = appendKeyIdentifiers(dst, v.Build)
+	dst = appendKeyZeros(dst, v.Prerelease)
+
+	return appendKeyZeros(dst, v.Build)
+}
+
+func appendKeyUint(dst []byte, val uint64) []byte {
+	n := byte(0)
+	for tmp := val; tmp > 0; tmp >>= 8 {
+		n++
+	}
+
+	dst = append(dst, n)
+	for i := int(n) - 1; i >= 0; i-- {
+		dst = append(dst, byte(val>>(uint(i)*8)))
+	}
+
+	return dst
+}
+
+func appendKeySize(dst []byte, size int) []byte {
+	if size < int(keyLongSize) {
+		return append(dst, byte(size))
+	}
+
+	return appendKeyUint(append(dst, keyLongSize), uint64(size))
+}
+
+func appendKeyIdentifiers(dst []byte, ids []string) []byte {
+	for _, id := range ids {
+		if isNumeric(id) {
+			trimmed := trimZeros(id)
+			dst = append(dst, keyNumeric)
+			dst = appendKeySize(dst, len(trimmed))
+			dst = append(dst, trimmed...)
+		} else {
+			dst = append(dst, keyAlpha)
+			for i := 0; i < len(id); i++ {
+				if id[i] == keyEnd {
+					dst = append(dst, keyEnd, keyEscape)
+				} else {
+					dst = append(dst, id[i])
+				}
+			}
+			dst = append(dst, keyEnd, keyTerm)
+		}
+	}
+
+	return append(dst, keyEnd)
+}
+
+func appendKeyZeros(dst []byte, ids []string) []byte {
+	for _, id := range ids {
+		if isNumeric(id) {
+			dst = append(dst, id[:len(id)-len(trimZeros(id))]...)
+			dst = append(dst, keyEnd)
+		}
+	}
+
+	return dst
+}
+
+func parseSortKey(key []byte) (v Version, err error) {
+	pos := 0
+
+	for _, part := range [vSegs]*uint64{&v.Major, &v.Minor, &v.Patch} {
+		if *part, err = readKeyUint(key, &pos); err != nil {
+			return
+		}
+	}
+
+	if pos < len(key) && key[pos] == keyRelease {
+		pos++
+	} else if v.Prerelease, err = readKeyIdentifiers(key, &pos); err != nil {
+		return
+	} else if len(v.Prerelease) == 0 {
+		return v, ErrInvalidSortKey
+	}
+
+	if v.Build, err = readKeyIdentifiers(key, &pos); err != nil {
+		return
+	}
+
+	if err = readKeyZeros(key, &pos, v.Prerelease); err != nil {
+		return
+	}
+
+	if err = readKeyZeros(key, &pos, v.Build); err != nil {
+		return
+	}
+
+	if pos != len(key) {
+		return v, ErrInvalidSortKey
+	}
+
+	return
+}
+
+func readKeyUint(key []byte, pos *int) (val uint64, err error) {
+	if *pos >= len(key) || key[*pos] > 8 || *pos+1+int(key[*pos]) > len(key) {
+		return 0, ErrInvalidSortKey
+	}
+
+	n := int(key[*pos])
+	*pos++
+
+	for end := *pos + n; *pos < end; *pos++ {
+		val = val<<8 | uint64(key[*pos])
+	}
+
+	return
+}
+
+func readKeySize(key []byte, pos *int) (int, error) {
+	if *pos >= len(key) {
+		return 0, ErrInvalidSortKey
+	}
+
+	if key[*pos] != keyLongSize {
+		*pos++
+		return int(key[*pos-1]), nil
+	}
+
+	*pos++
+	size, err := readKeyUint(key, pos)
+	if err != nil || size > uint64(len(key)) {
+		return 0, ErrInvalidSortKey
+	}
+
+	return int(size), nil
+}
+
+func readKeyIdentifiers(key []byte, pos *int) (out []string, err error) {
+	for {
+		if *pos >= len(key) {
+			return nil, ErrInvalidSortKey
+		}
+
+		tag := key[*pos]
+		*pos++
+
+		switch tag {
+		case keyEnd:
+			return out, nil
+
+		case keyNumeric:
+			id, err := readKeyNumeric(key, pos)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, id)
+
+		case keyAlpha:
+			id, err := readKeyAlpha(key, pos)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, id)
+
+		default:
+			return nil, ErrInvalidSortKey
+		}
+	}
+}
+
+func readKeyNumeric(key []byte, pos *int) (string, error) {
+	size, err := readKeySize(key, pos)
+	if err != nil || size == 0 || *pos+size > len(key) {
+		return "", ErrInvalidSortKey
+	}
+
+	out := string(key[*pos : *pos+size])
+	*pos += size
+
+	if !isNumeric(out) || trimZeros(out) != out {
+		return "", ErrInvalidSortKey
+	}
+
+	return out, nil
+}
+
+// readKeyZeros restores the leading zeros of each numeric identifier in the
+// given slice.
+func readKeyZeros(key []byte, pos *int, ids []string) error {
+	for i, id := range ids {
+		if !isNumeric(id) {
+			continue
+		}
+
+		start := *pos
+		for *pos < len(key) && key[*pos] == digit0 {
+			*pos++
+		}
+
+		if *pos >= len(key) || key[*pos] != keyEnd {
+			return ErrInvalidSortKey
+		}
+
+		if *pos > start {
+			ids[i] = string(key[start:*pos]) + id
+		}
+
+		*pos++
+	}
+
+	return nil
+}
+
+func readKeyAlpha(key []byte, pos *int) (string, error) {
+	out := make([]byte, 0, 16)
+
+	for *pos < len(key) {
+		b := key[*pos]
+		*pos++
+
+		if b != keyEnd {
+			out = append(out, b)
+			continue
+		}
+
+		if *pos >= len(key) {
+			break
+		}
+
+		switch key[*pos] {
+		case keyEscape:
+			*pos++
+			out = append(out, keyEnd)
+		case keyTerm:
+			*pos++
+			return string(out), nil
+		default:
+			return "", ErrInvalidSortKey
+		}
+	}
+
+	return "", ErrInvalidSortKey
+}
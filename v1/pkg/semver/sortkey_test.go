@@ -0,0 +1,131 @@
+package semver_test
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = semver.Version{}
+	_ encoding.BinaryUnmarshaler = (*semver.Version)(nil)
+)
+
+func TestVersion_AppendSortKey(t *testing.T) {
+	inputs := []string{
+		"1.0.0", "1.0.0-rc.1", "0.9.0", "1.0.0-alpha.beta", "1.0.0-alpha.1",
+		"1.0.0-alpha", "1.0.0-beta.11", "1.0.0-beta.2", "1.0.0-beta", "2.0.0",
+		"1.0.0-1", "1.0.0-alpha.18446744073709551616", "1.0.0-alpha.99999999999999999999999",
+	}
+
+	for _, a := range inputs {
+		for _, b := range inputs {
+			va, _ := semver.ParseStrict(a)
+			vb, _ := semver.ParseStrict(b)
+
+			ka := va.AppendSortKey(nil)
+			kb := vb.AppendSortKey([]byte{})
+
+			if bytes.Compare(ka, kb) != va.Compare(&vb) {
+				t.Errorf("Expected key order of %s and %s to match precedence", a, b)
+			}
+		}
+	}
+}
+
+func TestVersion_AppendSortKey_leadingZeros(t *testing.T) {
+	// Leading zeros are not permitted by ParseStrict, but are accepted by Parse.
+	// Versions differing only in leading zeros have equal precedence but
+	// distinct keys, so only the order of unequal versions is checked.
+	inputs := []string{
+		"1.0.0-01", "1.0.0-1", "1.0.0-1.x", "1.0.0-0001.a", "1.0.0-alpha.01",
+		"1.0.0-alpha.1", "1.0.0-alpha.2", "1.0.0-0", "1.0.0-00", "1.0.0",
+	}
+
+	for _, a := range inputs {
+		for _, b := range inputs {
+			va, _ := semver.Parse(a)
+			vb, _ := semver.Parse(b)
+
+			ka := va.AppendSortKey(nil)
+			kb := vb.AppendSortKey(nil)
+
+			if cmp := va.Compare(&vb); cmp != 0 && bytes.Compare(ka, kb) != cmp {
+				t.Errorf("Expected key order of %s and %s to match precedence", a, b)
+			}
+		}
+	}
+}
+
+func TestVersion_AppendSortKey_range(t *testing.T) {
+	lo := (&semver.Version{Major: 1, Prerelease: []string{"0"}}).AppendSortKey(nil)
+	hi := (&semver.Version{Major: 2, Prerelease: []string{"0"}}).AppendSortKey(nil)
+
+	tests := []struct {
+		version string
+		within  bool
+	}{
+		{"0.99.99", false},
+		{"1.0.0-alpha", true},
+		{"1.0.0", true},
+		{"1.999.0+b1", true},
+		{"2.0.0-rc.1", false},
+		{"2.0.0", false},
+	}
+
+	for _, test := range tests {
+		v, _ := semver.ParseStrict(test.version)
+		key := v.AppendSortKey(nil)
+
+		if within := bytes.Compare(key, lo) >= 0 && bytes.Compare(key, hi) < 0; within != test.within {
+			t.Errorf("Expected %s within [1.0.0-0, 2.0.0-0) to be %t", test.version, test.within)
+		}
+	}
+}
+
+func TestVersion_MarshalBinary(t *testing.T) {
+	tests := []semver.Version{
+		{},
+		{Major: 1, Minor: 2, Patch: 3},
+		{Major: 1 << 40, Prerelease: []string{"rc", "007", "a\x00b", ""}, Build: []string{"sha", "00", "x"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.String(), func(t *testing.T) {
+			data, err := test.MarshalBinary()
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+
+			var out semver.Version
+			if err := out.UnmarshalBinary(data); err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+
+			if out.String() != test.String() {
+				t.Errorf("Expected %q, got %q", test.String(), out.String())
+			}
+		})
+	}
+}
+
+func TestVersion_UnmarshalBinary_invalid(t *testing.T) {
+	tests := [][]byte{
+		nil,
+		{9, 0, 0, 3, 0},
+		{0, 0, 0, 3},
+		{0, 0, 0, 0, 0},
+		{0, 0, 0, 2, 'a', 0},
+		{0, 0, 0, 1, 1, 'a', 0, 0, 0},
+		{0, 0, 0, 3, 0, 7},
+	}
+
+	for _, test := range tests {
+		var out semver.Version
+		if err := out.UnmarshalBinary(test); err != semver.ErrInvalidSortKey {
+			t.Errorf("Expected ErrInvalidSortKey for %v, got %v", test, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"database/sql/driver"
+)
+
+// ErrScanType is returned when scanning a database value of an unsupported
+// type into a Version.
+var ErrScanType error = sentinel("unsupported database type for semantic version")
+
+// ErrScanNull is returned when scanning a NULL database value into a Version.
+// Use NullVersion for nullable columns.
+var ErrScanNull error = sentinel("cannot scan NULL into semantic version")
+
+// Value implements driver.Valuer, storing the Version as the string returned
+// by String.
+func (v Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements sql.Scanner, parsing string or []byte values with Parse.
+func (v *Version) Scan(src interface{}) (err error) {
+	switch val := src.(type) {
+	case string:
+		*v, err = Parse(val)
+	case []byte:
+		*v, err = Parse(string(val))
+	case nil:
+		err = ErrScanNull
+	default:
+		err = ErrScanType
+	}
+
+	return
+}
+
+// NullVersion is a Version that may be NULL in a database.
+type NullVersion struct {
+	Version Version
+
+	// Valid is true if Version is not NULL.
+	Valid bool
+}
+
+// Value implements driver.Valuer.
+func (n NullVersion) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Version.Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullVersion) Scan(src interface{}) error {
+	if src == nil {
+		n.Version, n.Valid = Version{}, false
+		return nil
+	}
+
+	if err := n.Version.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// SortableVersion is a Version stored in a database as a binary sort key whose
+// byte order matches SemVer precedence, so that ORDER BY on a BLOB or bytea
+// column sorts versions correctly.
+//
+// Versions of equal precedence are ordered by their build metadata.
+type SortableVersion Version
+
+// Value implements driver.Valuer, storing the version as a binary sort key.
+func (s SortableVersion) Value() (driver.Value, error) {
+	return appendSortKey(nil, (*Version)(&s)), nil
+}
+
+// Scan implements sql.Scanner, decoding a binary sort key.
+func (s *SortableVersion) Scan(src interface{}) (err error) {
+	var v Version
+
+	switch val := src.(type) {
+	case []byte:
+		v, err = parseSortKey(val)
+	case string:
+		v, err = parseSortKey([]byte(val))
+	case nil:
+		err = ErrScanNull
+	default:
+		err = ErrScanType
+	}
+
+	if err == nil {
+		*s = SortableVersion(v)
+	}
+
+	return
+}
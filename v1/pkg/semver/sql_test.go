@@ -0,0 +1,125 @@
+package semver_test
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+var (
+	_ sql.Scanner   = (*semver.Version)(nil)
+	_ driver.Valuer = semver.Version{}
+	_ sql.Scanner   = (*semver.NullVersion)(nil)
+	_ driver.Valuer = semver.NullVersion{}
+	_ sql.Scanner   = (*semver.SortableVersion)(nil)
+	_ driver.Valuer = semver.SortableVersion{}
+)
+
+func TestVersion_Scan(t *testing.T) {
+	tests := []interface{}{"v1.2.3-rc.1+b5", []byte("1.2.3-rc.1+b5")}
+
+	for _, test := range tests {
+		var v semver.Version
+		if err := v.Scan(test); err != nil {
+			t.Fatal("expected no error, got ", err)
+		}
+
+		val, _ := v.Value()
+		if val != "1.2.3-rc.1+b5" {
+			t.Errorf("Expected 1.2.3-rc.1+b5, got %v", val)
+		}
+	}
+
+	var v semver.Version
+	if err := v.Scan(nil); err != semver.ErrScanNull {
+		t.Errorf("Expected ErrScanNull, got %v", err)
+	}
+	if err := v.Scan(12); err != semver.ErrScanType {
+		t.Errorf("Expected ErrScanType, got %v", err)
+	}
+}
+
+func TestNullVersion_Scan(t *testing.T) {
+	n := semver.NullVersion{Valid: true}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+	if n.Valid {
+		t.Error("Expected NULL to produce an invalid NullVersion")
+	}
+	if val, _ := n.Value(); val != nil {
+		t.Errorf("Expected nil value, got %v", val)
+	}
+
+	if err := n.Scan("1.2.3"); err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+	if !n.Valid || n.Version.String() != "1.2.3" {
+		t.Errorf("Expected valid 1.2.3, got %t %s", n.Valid, n.Version.String())
+	}
+	if val, _ := n.Value(); val != "1.2.3" {
+		t.Errorf("Expected 1.2.3, got %v", val)
+	}
+}
+
+func TestSortableVersion(t *testing.T) {
+	ordered := []string{
+		"0.0.0-0",
+		"0.0.0",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.0+b1",
+		"1.9.0",
+		"1.10.0",
+		"1.255.0",
+		"1.256.0",
+		"2.0.0",
+		"18446744073709551615.0.0",
+	}
+
+	var prev []byte
+
+	for _, s := range ordered {
+		v, _ := semver.ParseStrict(s)
+
+		val, err := semver.SortableVersion(v).Value()
+		if err != nil {
+			t.Fatal("expected no error, got ", err)
+		}
+
+		key := val.([]byte)
+		if prev != nil && bytes.Compare(prev, key) >= 0 {
+			t.Errorf("Expected key for %s to sort after the previous key", s)
+		}
+		prev = key
+
+		var out semver.SortableVersion
+		if err := out.Scan(key); err != nil {
+			t.Fatalf("expected no error scanning %s, got %s", s, err)
+		}
+		if rt := semver.Version(out); rt.String() != s {
+			t.Errorf("Expected %s, got %s", s, rt.String())
+		}
+	}
+}
+
+func TestSortableVersion_Scan_invalid(t *testing.T) {
+	tests := []interface{}{[]byte{}, []byte{1}, []byte{0, 0, 0}, []byte{0, 0, 0, 3}, "1.2.3", 4, nil}
+
+	for _, test := range tests {
+		var out semver.SortableVersion
+		if err := out.Scan(test); err == nil {
+			t.Errorf("Expected an error scanning %#v", test)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package semver
+
+import (
+	"github.com/foxcapades/gVersion/v1/internal/util"
+)
+
+// ParseStrict parses the given string as a semantic version, enforcing the
+// SemVer 2.0.0 grammar exactly.
+//
+// Unlike Parse, ParseStrict rejects:
+//   - a leading 'v' character
+//   - versions with fewer or more than 3 numeric components
+//   - leading zeros in numeric components and numeric prerelease identifiers
+//   - empty prerelease or build identifiers
+//   - characters other than [0-9A-Za-z-] in prerelease or build identifiers
+func ParseStrict(versionString string) (version Version, err error) {
+	ln := len(versionString)
+	pos := 0
+
+	if ln == 0 {
+		return version, &ParseError{Reason: ReasonEmpty}
+	}
+
+	parts := [vSegs]*uint64{&version.Major, &version.Minor, &version.Patch}
+
+	for i := range parts {
+		comp := ComponentMajor + Component(i)
+
+		if err = parseStrictNumber(versionString, &pos, comp, parts[i]); err != nil {
+			return
+		}
+
+		if i < vSegs-1 {
+			switch true {
+			case pos >= ln:
+				return version, newParseError(versionString, pos, comp+1, ReasonMissingNumber)
+			case versionString[pos] != segDivider:
+				return version, newParseError(versionString, pos, comp, ReasonInvalidCharacter)
+			}
+			pos++
+		}
+	}
+
+	if pos < ln && versionString[pos] == preDivider {
+		pos++
+		version.Prerelease, err = parseStrictIdentifiers(versionString, &pos, ComponentPrerelease)
+		if err != nil {
+			return
+		}
+	}
+
+	if pos < ln && versionString[pos] == buildDivider {
+		pos++
+		version.Build, err = parseStrictIdentifiers(versionString, &pos, ComponentBuild)
+		if err != nil {
+			return
+		}
+	}
+
+	if pos < ln {
+		if versionString[pos] == segDivider {
+			return version, newParseError(versionString, pos, ComponentPatch, ReasonTooManyComponents)
+		}
+
+		return version, newParseError(versionString, pos, ComponentPatch, ReasonInvalidCharacter)
+	}
+
+	return
+}
+
+func parseStrictNumber(vn string, pos *int, comp Component, out *uint64) error {
+	start := *pos
+
+	for ; *pos < len(vn) && isDigit(vn[*pos]); *pos++ {
+		var ok bool
+		if *out, ok = util.ShiftDigitU64(*out, vn[*pos]-digit0); !ok {
+			return newParseError(vn, *pos, comp, ReasonOverflow)
+		}
+	}
+
+	switch true {
+	case *pos == start:
+		return newParseError(vn, start, comp, ReasonMissingNumber)
+	case *pos-start > 1 && vn[start] == digit0:
+		return newParseError(vn, start, comp, ReasonLeadingZero)
+	}
+
+	return nil
+}
+
+func parseStrictIdentifiers(vn string, pos *int, comp Component) (out []string, err error) {
+	pre := comp == ComponentPrerelease
+
+	for {
+		start := *pos
+
+		for *pos < len(vn) && isIdentifierChar(vn[*pos]) {
+			*pos++
+		}
+
+		id := vn[start:*pos]
+
+		switch true {
+		case len(id) == 0:
+			return nil, newParseError(vn, start, comp, ReasonEmptyIdentifier)
+		case pre && len(id) > 1 && id[0] == digit0 && isNumeric(id):
+			return nil, newParseError(vn, start, comp, ReasonLeadingZero)
+		}
+
+		out = append(out, id)
+
+		if *pos >= len(vn) || vn[*pos] != segDivider {
+			break
+		}
+
+		*pos++
+	}
+
+	if *pos < len(vn) && !(pre && vn[*pos] == buildDivider) {
+		return nil, newParseError(vn, *pos, comp, ReasonInvalidCharacter)
+	}
+
+	return
+}
+
+func isDigit(b byte) bool {
+	return b >= digit0 && b <= digit9
+}
+
+func isIdentifierChar(b byte) bool {
+	return isDigit(b) || b == preDivider || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
@@ -0,0 +1,70 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		version string
+		output  semver.Version
+	}{
+		{"0.0.0", semver.Version{}},
+		{"1.2.3", semver.Version{Major: 1, Minor: 2, Patch: 3}},
+		{"10.20.30", semver.Version{Major: 10, Minor: 20, Patch: 30}},
+		{"1.0.0-0", semver.Version{Major: 1, Prerelease: []string{"0"}}},
+		{"1.0.0-alpha.0a", semver.Version{Major: 1, Prerelease: []string{"alpha", "0a"}}},
+		{"1.0.0-x-y-z.--", semver.Version{Major: 1, Prerelease: []string{"x-y-z", "--"}}},
+		{"1.0.0+001", semver.Version{Major: 1, Build: []string{"001"}}},
+		{"1.0.0-rc.1+build.1", semver.Version{Major: 1, Prerelease: []string{"rc", "1"}, Build: []string{"build", "1"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			vs, err := semver.ParseStrict(test.version)
+
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+
+			if !vs.Equal(&test.output) || vs.String() != test.version {
+				t.Errorf("Expected %s, got %s", test.version, vs.String())
+			}
+		})
+	}
+}
+
+func TestParseStrict_invalid(t *testing.T) {
+	tests := [][2]string{
+		{"", "invalid semantic version string: empty version string at offset 0"},
+		{"v1.2.3", "invalid semantic version string: missing number in major version at offset 0"},
+		{"1.2x3", "invalid semantic version string: invalid character 'x' in minor version at offset 3"},
+		{"1.2", "invalid semantic version string: missing number in patch version at offset 3"},
+		{"1.2.3.4", "invalid semantic version string: too many numeric components in patch version at offset 5"},
+		{"01.2.3", "invalid semantic version string: leading zero in major version at offset 0"},
+		{"1.02.3", "invalid semantic version string: leading zero in minor version at offset 2"},
+		{"1..3", "invalid semantic version string: missing number in minor version at offset 2"},
+		{"1.0.0-", "invalid semantic version string: empty identifier in prerelease at offset 6"},
+		{"1.0.0-a..b", "invalid semantic version string: empty identifier in prerelease at offset 8"},
+		{"1.0.0-01", "invalid semantic version string: leading zero in prerelease at offset 6"},
+		{"1.0.0-a b", "invalid semantic version string: invalid character ' ' in prerelease at offset 7"},
+		{"1.0.0+", "invalid semantic version string: empty identifier in build metadata at offset 6"},
+		{"1.0.0+a+b", "invalid semantic version string: invalid character '+' in build metadata at offset 7"},
+		{"1.0.0+a_b", "invalid semantic version string: invalid character '_' in build metadata at offset 7"},
+	}
+
+	for _, test := range tests {
+		t.Run(test[0], func(t *testing.T) {
+			_, err := semver.ParseStrict(test[0])
+
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if err.Error() != test[1] {
+				t.Errorf("Expected %q, got %q", test[1], err.Error())
+			}
+		})
+	}
+}
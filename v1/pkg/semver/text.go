@@ -0,0 +1,48 @@
+package semver
+
+// MarshalText implements encoding.TextMarshaler, returning the same value as
+// String.
+func (v Version) MarshalText() ([]byte, error) {
+	out := make([]byte, v.outSize())
+	v.stringFill(out, 0)
+
+	return out, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing the given text
+// with Parse.
+func (v *Version) UnmarshalText(text []byte) (err error) {
+	*v, err = Parse(string(text))
+	return
+}
+
+// VVersion is a Version that marshals to text with a leading 'v' character, as
+// returned by VString.
+//
+// Convert between the two types to choose how a version field is encoded:
+//
+//   cfg.Version = semver.VVersion(ver)
+type VVersion Version
+
+// MarshalText implements encoding.TextMarshaler, returning the same value as
+// Version.VString.
+func (v VVersion) MarshalText() ([]byte, error) {
+	ver := Version(v)
+	out := make([]byte, ver.outSize()+1)
+	out[0] = leader
+	ver.stringFill(out, 1)
+
+	return out, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing the given text
+// with Parse.
+func (v *VVersion) UnmarshalText(text []byte) error {
+	return (*Version)(v).UnmarshalText(text)
+}
+
+// String returns the same value as Version.VString.
+func (v VVersion) String() string {
+	ver := Version(v)
+	return ver.VString()
+}
@@ -0,0 +1,82 @@
+package semver_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/foxcapades/gVersion/v1/pkg/semver"
+)
+
+var (
+	_ encoding.TextMarshaler   = semver.Version{}
+	_ encoding.TextUnmarshaler = (*semver.Version)(nil)
+	_ encoding.TextMarshaler   = semver.VVersion{}
+	_ encoding.TextUnmarshaler = (*semver.VVersion)(nil)
+)
+
+func TestVersion_MarshalText(t *testing.T) {
+	tests := []struct {
+		expect string
+		input  semver.Version
+	}{
+		{"1.2.3", semver.Version{Major: 1, Minor: 2, Patch: 3}},
+		{"0.0.0-alpha.v1+2020-09-18.b21", semver.Version{Prerelease: []string{"alpha", "v1"}, Build: []string{"2020-09-18", "b21"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expect, func(t *testing.T) {
+			out, err := test.input.MarshalText()
+			if err != nil {
+				t.Fatal("expected no error, got ", err)
+			}
+			if string(out) != test.expect {
+				t.Errorf("Expected %s, got %s", test.expect, out)
+			}
+
+			vOut, _ := semver.VVersion(test.input).MarshalText()
+			if string(vOut) != "v"+test.expect {
+				t.Errorf("Expected v%s, got %s", test.expect, vOut)
+			}
+		})
+	}
+}
+
+func TestVersion_UnmarshalText(t *testing.T) {
+	var v semver.Version
+
+	if err := v.UnmarshalText([]byte("v1.2.3-rc.1+b5")); err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+	if v.String() != "1.2.3-rc.1+b5" {
+		t.Errorf("Expected 1.2.3-rc.1+b5, got %s", v.String())
+	}
+
+	if err := v.UnmarshalText([]byte("1.x")); err == nil {
+		t.Error("expected an error, got nil")
+	}
+}
+
+func TestVersion_textFields(t *testing.T) {
+	type config struct {
+		Plain  semver.Version  `json:"plain"`
+		Leader semver.VVersion `json:"leader"`
+	}
+
+	in := `{"plain":"v1.2.3","leader":"4.5.6-rc.1"}`
+
+	var cfg config
+	if err := json.Unmarshal([]byte(in), &cfg); err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+
+	out, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal("expected no error, got ", err)
+	}
+
+	expect := `{"plain":"1.2.3","leader":"v4.5.6-rc.1"}`
+	if string(out) != expect {
+		t.Errorf("Expected %s, got %s", expect, out)
+	}
+}